@@ -0,0 +1,125 @@
+package nntp
+
+import (
+	"bytes"
+	"crypto/rand"
+	"encoding/hex"
+	"fmt"
+	"hash/crc32"
+	"io"
+	"strconv"
+	"strings"
+)
+
+// DefaultPartSize is the number of bytes of a file PostBinary puts in each
+// article if the Binary doesn't say.
+const DefaultPartSize = 716800
+
+// A Binary describes a file to be posted as a series of yEnc encoded
+// articles.
+type Binary struct {
+	Name       string   // File name, as given in the yEnc headers
+	Subject    string   // Subject of the articles, before the part numbers; Name if empty
+	From       string   // Poster of the articles
+	Newsgroups []string // Groups to post the articles to
+	Domain     string   // Domain used in the articles' message-ids
+
+	PartSize   int64 // Bytes of the file per article; DefaultPartSize if zero
+	LineLength int   // Length of yEnc lines; DefaultYencLineLength if zero
+}
+
+// PostBinary posts the size bytes of r as a series of articles, yEnc
+// encoded, with subjects such as "name (01/37)". It returns the
+// message-ids of the articles posted, in order, which are all of them
+// unless an error is returned.
+func (c *Conn) PostBinary(b Binary, r io.ReaderAt, size int64) ([]string, error) {
+	partSize := b.PartSize
+	if partSize <= 0 {
+		partSize = DefaultPartSize
+	}
+	subject := b.Subject
+	if subject == "" {
+		subject = b.Name
+	}
+	total := int((size + partSize - 1) / partSize)
+	if total == 0 {
+		total = 1
+	}
+	width := len(strconv.Itoa(total))
+	if width < 2 {
+		width = 2
+	}
+
+	// The CRC32 of the whole file goes in every part.
+	crc := crc32.NewIEEE()
+	if _, err := io.Copy(crc, io.NewSectionReader(r, 0, size)); err != nil {
+		return nil, err
+	}
+
+	var ids []string
+	for part := 1; part <= total; part++ {
+		begin := int64(part-1) * partSize
+		end := begin + partSize
+		if end > size {
+			end = size
+		}
+
+		h := YencHeader{
+			Name:  b.Name,
+			Size:  size,
+			Line:  b.LineLength,
+			Begin: begin + 1,
+			End:   end,
+			CRC32: crc.Sum32(),
+		}
+		if total > 1 {
+			h.Part, h.Total = part, total
+		}
+		var body bytes.Buffer
+		y := NewYencEncoder(&body, h)
+		if _, err := io.Copy(y, io.NewSectionReader(r, begin, end-begin)); err != nil {
+			return ids, err
+		}
+		if err := y.Close(); err != nil {
+			return ids, err
+		}
+
+		id, err := newMessageID(b.Domain)
+		if err != nil {
+			return ids, err
+		}
+		// Unlike Post, the body is sent as it is, and the subject is
+		// left unencoded: binary indexers, Collect among them, match
+		// subjects as they are.
+		a := &Article{
+			Header: Header{
+				{"From", EncodeHeader("From", b.From)},
+				{"Newsgroups", strings.Join(b.Newsgroups, ",")},
+				{"Subject", fmt.Sprintf("%s (%0*d/%0*d)", subject, width, part, width, total)},
+				{"Message-ID", id},
+			},
+			Body: &body,
+		}
+		if err := a.Validate(); err != nil {
+			return ids, err
+		}
+		if err := c.RawPost(&articleReader{a: a}); err != nil {
+			return ids, err
+		}
+		ids = append(ids, id)
+	}
+	return ids, nil
+}
+
+// newMessageID returns a new, random message-id in domain, or in
+// "nntp.invalid" if domain is empty.
+func newMessageID(domain string) (string, error) {
+	if domain == "" {
+		domain = "nntp.invalid"
+	}
+	var buf [16]byte
+	if _, err := rand.Read(buf[:]); err != nil {
+		return "", err
+	}
+	return "<" + hex.EncodeToString(buf[:]) + "@" + domain + ">", nil
+}
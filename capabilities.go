@@ -0,0 +1,170 @@
+package nntp
+
+import (
+	"strings"
+)
+
+// Capabilities describes the features a server advertises in response to
+// the CAPABILITIES command, as defined in RFC 3977 section 5.2.
+//
+// Capabilities are tied to the state of the session: they may change
+// after MODE READER, authentication or STARTTLS, and a Conn forgets them
+// at those points.
+type Capabilities struct {
+	Version        []string // Protocol versions, usually just "2"
+	Reader         bool     // Commands for reading articles are available
+	ModeReader     bool     // MODE READER is needed to read articles
+	Post           bool     // POST is available
+	IHave          bool     // IHAVE is available
+	Streaming      bool     // MODE STREAM, CHECK and TAKETHIS are available (RFC 4644)
+	NewNews        bool     // NEWNEWS is available
+	Over           bool     // OVER is available
+	OverMsgID      bool     // OVER accepts a message-id
+	Hdr            bool     // HDR is available
+	StartTLS       bool     // STARTTLS is available (RFC 4642)
+	List           []string // LIST keywords, e.g. "ACTIVE" or "NEWSGROUPS"
+	AuthInfo       []string // AUTHINFO variants, "USER" and/or "SASL" (RFC 4643)
+	SASL           []string // SASL mechanisms for AUTHINFO SASL, e.g. "PLAIN"
+	Compress       []string // COMPRESS algorithms, e.g. "DEFLATE" (RFC 8054)
+	Implementation string   // Description of the server software, if given
+
+	// Lines holds the capability lines as the server sent them.
+	Lines []string
+
+	labels map[string][]string
+}
+
+// Has reports whether the server advertised the capability label, which
+// may be a standard capability or an extension such as "XZVER".
+func (caps *Capabilities) Has(label string) bool {
+	_, ok := caps.labels[strings.ToUpper(label)]
+	return ok
+}
+
+// Args returns the arguments the server advertised for the capability
+// label, or nil if it did not advertise label.
+func (caps *Capabilities) Args(label string) []string {
+	return caps.labels[strings.ToUpper(label)]
+}
+
+// HasArg reports whether the server advertised the capability label with
+// the argument arg.
+func (caps *Capabilities) HasArg(label, arg string) bool {
+	return hasFold(caps.Args(label), arg)
+}
+
+// hasFold reports whether ss contains s, ignoring case.
+func hasFold(ss []string, s string) bool {
+	for _, v := range ss {
+		if strings.EqualFold(v, s) {
+			return true
+		}
+	}
+	return false
+}
+
+// parseCapabilities parses the lines of a CAPABILITIES response.
+func parseCapabilities(lines []string) *Capabilities {
+	caps := &Capabilities{
+		Lines:  lines,
+		labels: make(map[string][]string),
+	}
+	for _, line := range lines {
+		ss := strings.Fields(line)
+		if len(ss) == 0 {
+			continue
+		}
+		label, args := strings.ToUpper(ss[0]), ss[1:]
+		caps.labels[label] = args
+
+		switch label {
+		case "VERSION":
+			caps.Version = args
+		case "READER":
+			caps.Reader = true
+		case "MODE-READER":
+			caps.ModeReader = true
+		case "POST":
+			caps.Post = true
+		case "IHAVE":
+			caps.IHave = true
+		case "STREAMING":
+			caps.Streaming = true
+		case "NEWNEWS":
+			caps.NewNews = true
+		case "OVER":
+			caps.Over = true
+			caps.OverMsgID = hasFold(args, "MSGID")
+		case "HDR":
+			caps.Hdr = true
+		case "STARTTLS":
+			caps.StartTLS = true
+		case "LIST":
+			caps.List = upper(args)
+		case "AUTHINFO":
+			caps.AuthInfo = upper(args)
+		case "SASL":
+			caps.SASL = upper(args)
+		case "COMPRESS":
+			caps.Compress = upper(args)
+		case "IMPLEMENTATION":
+			caps.Implementation = strings.TrimSpace(line[len(ss[0]):])
+		}
+	}
+	return caps
+}
+
+func upper(ss []string) []string {
+	res := make([]string, len(ss))
+	for i, s := range ss {
+		res[i] = strings.ToUpper(s)
+	}
+	return res
+}
+
+// An UnsupportedError is returned by methods of Conn that did not send a
+// command because the server's capabilities show it isn't supported.
+type UnsupportedError string
+
+func (e UnsupportedError) Error() string {
+	return "server does not support " + string(e)
+}
+
+// Capabilities returns the features this server performs. The result is
+// cached until the session changes in a way that could affect it.
+// Not all servers support capabilities.
+func (c *Conn) Capabilities() (*Capabilities, error) {
+	if c.caps != nil {
+		return c.caps, nil
+	}
+	if _, _, err := c.cmd(101, "CAPABILITIES"); err != nil {
+		return nil, err
+	}
+	lines, err := readStrings(c.r)
+	if err != nil {
+		return nil, err
+	}
+	c.caps = parseCapabilities(lines)
+	return c.caps, nil
+}
+
+// capabilities returns the server's capabilities, or nil if it won't say,
+// in which case the caller should fall back to trying commands.
+func (c *Conn) capabilities() (*Capabilities, error) {
+	if c.caps == nil && !c.capsUnavailable {
+		if _, err := c.Capabilities(); err != nil {
+			if _, ok := err.(Error); !ok {
+				return nil, err
+			}
+			c.capsUnavailable = true
+		}
+	}
+	return c.caps, nil
+}
+
+// forgetCapabilities discards cached capabilities, as RFC 3977 requires
+// whenever the server may have changed them.
+func (c *Conn) forgetCapabilities() {
+	c.caps = nil
+	c.capsUnavailable = false
+}
@@ -0,0 +1,72 @@
+package nntp
+
+import (
+	"bufio"
+	"bytes"
+	"strings"
+	"testing"
+)
+
+func TestCapabilities(t *testing.T) {
+	var cmdbuf bytes.Buffer
+	var fake faker
+	fake.Writer = &cmdbuf
+
+	conn := &Conn{conn: fake, w: fake, r: bufio.NewReader(strings.NewReader(capsServer))}
+
+	caps, err := conn.Capabilities()
+	if err != nil {
+		t.Fatal("CAPABILITIES shouldn't error: " + err.Error())
+	}
+	if strings.Join(caps.Version, " ") != "2 3" || !caps.Reader || caps.Post || !caps.Over || !caps.OverMsgID ||
+		strings.Join(caps.List, " ") != "ACTIVE NEWSGROUPS" || strings.Join(caps.SASL, " ") != "PLAIN CRAM-MD5" ||
+		caps.Implementation != "INN 2.6.4" || !caps.HasArg("xfeature-compress", "gzip") {
+		t.Fatalf("capabilities parsed incorrectly: %+v", caps)
+	}
+
+	// Cached capabilities shouldn't be asked for again...
+	if _, err := conn.List("OVERVIEW.FMT"); err == nil {
+		t.Fatal("LIST OVERVIEW.FMT should be refused")
+	} else if _, ok := err.(UnsupportedError); !ok {
+		t.Fatalf("LIST OVERVIEW.FMT should return an UnsupportedError, got %v", err)
+	}
+
+	// ...until authentication, which uses SASL as USER isn't offered.
+	if err := conn.Authenticate("user", "pass"); err != nil {
+		t.Fatal("Authenticate shouldn't error: " + err.Error())
+	}
+	if conn.caps != nil {
+		t.Fatal("authentication should discard capabilities")
+	}
+
+	if _, err := conn.Overview(10, 11); err != nil {
+		t.Fatal("Overview shouldn't error: " + err.Error())
+	}
+
+	if actualcmds := cmdbuf.String(); actualcmds != capsClient {
+		t.Fatalf("Got:\n%s\nExpected\n%s", actualcmds, capsClient)
+	}
+}
+
+var capsServer = "101 Capability list:\r\n" +
+	"VERSION 2 3\r\n" +
+	"READER\r\n" +
+	"OVER MSGID\r\n" +
+	"LIST ACTIVE NEWSGROUPS\r\n" +
+	"AUTHINFO SASL\r\n" +
+	"SASL PLAIN CRAM-MD5\r\n" +
+	"XFEATURE-COMPRESS GZIP TERMINATOR\r\n" +
+	"IMPLEMENTATION INN 2.6.4\r\n" +
+	".\r\n" +
+	"281 Authentication accepted\r\n" +
+	"101 Capability list:\r\n" +
+	"VERSION 2\r\n" +
+	"READER\r\n" +
+	".\r\n" +
+	"224 Overview information follows\r\n" +
+	".\r\n"
+
+var capsClient = "CAPABILITIES\r\n" +
+	"AUTHINFO SASL PLAIN AHVzZXIAcGFzcw==\r\n" +
+	"CAPABILITIES\r\n" +
+	"XOVER 10-11\r\n"
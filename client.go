@@ -0,0 +1,230 @@
+package nntp
+
+import (
+	"io"
+	"sync"
+)
+
+// A Client is a connection to a news server that is safe for concurrent
+// use. Commands from different goroutines are sent one at a time, in the
+// order the goroutines asked: callers wait in a queue, so none is starved
+// by others that keep asking.
+//
+// Responses with a body are returned as readers that hold the connection
+// until they are closed or read to the end; other callers wait until then.
+// Reading from one after it is closed returns ErrStaleReader.
+//
+// The server's notion of the selected group and article is shared by all
+// callers, so commands that rely on it should be made in one call to Do.
+type Client struct {
+	mu     queueLock // held while a command or response is in progress
+	c      *Conn
+	closed bool
+}
+
+// A queueLock is a mutex that is handed to those waiting for it in the
+// order they asked, which sync.Mutex doesn't promise.
+type queueLock struct {
+	mu      sync.Mutex
+	held    bool
+	waiting []chan struct{}
+}
+
+func (l *queueLock) Lock() {
+	l.mu.Lock()
+	if !l.held {
+		l.held = true
+		l.mu.Unlock()
+		return
+	}
+	ready := make(chan struct{})
+	l.waiting = append(l.waiting, ready)
+	l.mu.Unlock()
+	<-ready
+}
+
+// Unlock hands the lock to the longest waiting caller, if any.
+func (l *queueLock) Unlock() {
+	l.mu.Lock()
+	defer l.mu.Unlock()
+	if len(l.waiting) == 0 {
+		l.held = false
+		return
+	}
+	close(l.waiting[0])
+	l.waiting = l.waiting[1:]
+}
+
+// NewClient returns a Client that sends its commands over c, which must not
+// be used directly while the Client is in use.
+func NewClient(c *Conn) *Client {
+	return &Client{c: c}
+}
+
+// lock waits for the connection, returning ErrConnClosed if the Client
+// is closed.
+func (cl *Client) lock() error {
+	cl.mu.Lock()
+	if cl.closed {
+		cl.mu.Unlock()
+		return ErrConnClosed
+	}
+	return nil
+}
+
+// Do calls f with the connection, which no other caller uses until f
+// returns. Readers f obtains from the connection must not be used once f
+// returns.
+func (cl *Client) Do(f func(c *Conn) error) error {
+	if err := cl.lock(); err != nil {
+		return err
+	}
+	defer cl.mu.Unlock()
+	return f(cl.c)
+}
+
+// reader starts a response whose body is read from r, which keeps the
+// connection until it is closed or exhausted. The connection must be
+// locked, and is unlocked if err is set.
+func (cl *Client) reader(r io.Reader, err error) (io.ReadCloser, error) {
+	if err != nil {
+		cl.mu.Unlock()
+		return nil, err
+	}
+	return &clientReader{cl: cl, r: r}, nil
+}
+
+// clientReader is a response body that holds its Client until closed.
+type clientReader struct {
+	cl   *Client
+	r    io.Reader
+	done bool  // the Client has been released
+	err  error // what reads return once done
+}
+
+func (r *clientReader) Read(p []byte) (n int, err error) {
+	if r.done {
+		return 0, r.err
+	}
+	n, err = r.r.Read(p)
+	if err != nil {
+		r.release(err)
+	}
+	return
+}
+
+// Close ends the response, as the Close method of the reader returned by
+// the Conn does, and lets the next caller use the connection.
+func (r *clientReader) Close() error {
+	var err error
+	if !r.done {
+		if c, ok := r.r.(io.Closer); ok {
+			err = c.Close()
+		}
+		r.release(ErrStaleReader)
+	}
+	r.err = ErrStaleReader
+	return err
+}
+
+func (r *clientReader) release(err error) {
+	r.done, r.err = true, err
+	r.cl.mu.Unlock()
+}
+
+// Body returns the body of the article named by id, as Conn.Body does.
+// The body must be closed.
+func (cl *Client) Body(id string) (io.ReadCloser, error) {
+	if err := cl.lock(); err != nil {
+		return nil, err
+	}
+	return cl.reader(cl.c.Body(id))
+}
+
+// ArticleText returns the article named by id, as Conn.ArticleText does.
+// The article must be closed.
+func (cl *Client) ArticleText(id string) (io.ReadCloser, error) {
+	if err := cl.lock(); err != nil {
+		return nil, err
+	}
+	return cl.reader(cl.c.ArticleText(id))
+}
+
+// HeadText returns the headers of the article named by id, as
+// Conn.HeadText does. The headers must be closed.
+func (cl *Client) HeadText(id string) (io.ReadCloser, error) {
+	if err := cl.lock(); err != nil {
+		return nil, err
+	}
+	return cl.reader(cl.c.HeadText(id))
+}
+
+// Article returns the article named by id, as Conn.Article does. The
+// article must be closed.
+func (cl *Client) Article(id string) (*Article, error) {
+	if err := cl.lock(); err != nil {
+		return nil, err
+	}
+	a, err := cl.c.Article(id)
+	if err != nil {
+		cl.mu.Unlock()
+		return nil, err
+	}
+	a.Body = &clientReader{cl: cl, r: a.Body}
+	return a, nil
+}
+
+// Head returns the headers of the article named by id, as Conn.Head does.
+func (cl *Client) Head(id string) (a *Article, err error) {
+	err = cl.Do(func(c *Conn) (err error) {
+		a, err = c.Head(id)
+		return
+	})
+	return
+}
+
+// Stat checks whether the article named by id exists, as Conn.Stat does.
+func (cl *Client) Stat(id string) (number, msgid string, err error) {
+	err = cl.Do(func(c *Conn) (err error) {
+		number, msgid, err = c.Stat(id)
+		return
+	})
+	return
+}
+
+// Group selects a group, as Conn.Group does.
+func (cl *Client) Group(group string) (status *Group, err error) {
+	err = cl.Do(func(c *Conn) (err error) {
+		status, err = c.Group(group)
+		return
+	})
+	return
+}
+
+// Overview returns overviews of articles in the selected group, as
+// Conn.Overview does.
+func (cl *Client) Overview(begin, end int64) (overviews []MessageOverview, err error) {
+	err = cl.Do(func(c *Conn) (err error) {
+		overviews, err = c.Overview(begin, end)
+		return
+	})
+	return
+}
+
+// Post posts an article, as Conn.Post does.
+func (cl *Client) Post(a *Article) error {
+	return cl.Do(func(c *Conn) error {
+		return c.Post(a)
+	})
+}
+
+// Close waits for the connection, then ends the session with QUIT and
+// closes it. Later calls return ErrConnClosed.
+func (cl *Client) Close() error {
+	if err := cl.lock(); err != nil {
+		return err
+	}
+	defer cl.mu.Unlock()
+	cl.closed = true
+	return cl.c.Quit()
+}
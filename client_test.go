@@ -0,0 +1,111 @@
+package nntp
+
+import (
+	"bufio"
+	"bytes"
+	"io/ioutil"
+	"strings"
+	"sync"
+	"testing"
+	"time"
+)
+
+func TestClient(t *testing.T) {
+	var cmdbuf bytes.Buffer
+	var fake faker
+	fake.Writer = &cmdbuf
+
+	server := "222 1 <a@x>\r\n" +
+		"body\r\n" +
+		".\r\n" +
+		"223 1 <a@x>\r\n" +
+		"222 1 <a@x>\r\n" +
+		"body\r\n" +
+		".\r\n" +
+		"205 Bye!\r\n"
+	cl := NewClient(&Conn{conn: fake, w: fake, r: bufio.NewReader(strings.NewReader(server))})
+
+	body, err := cl.Body("<a@x>")
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	// Another caller waits until the body is read.
+	statted := make(chan error)
+	go func() {
+		_, _, err := cl.Stat("<a@x>")
+		statted <- err
+	}()
+	select {
+	case <-statted:
+		t.Fatal("Stat shouldn't run while a body is being read")
+	case <-time.After(50 * time.Millisecond):
+	}
+	if data, err := ioutil.ReadAll(body); err != nil || string(data) != "body\n" {
+		t.Fatalf("unexpected body %q, %v", data, err)
+	}
+	if err := <-statted; err != nil {
+		t.Fatal("Stat shouldn't error: " + err.Error())
+	}
+
+	// A body closed early can't be read.
+	body, err = cl.Body("<a@x>")
+	if err != nil {
+		t.Fatal(err)
+	}
+	body.Close()
+	if _, err := body.Read(make([]byte, 10)); err != ErrStaleReader {
+		t.Fatalf("expected ErrStaleReader, got %v", err)
+	}
+
+	if err := cl.Close(); err != nil {
+		t.Fatal(err)
+	}
+	if _, _, err := cl.Stat("<a@x>"); err != ErrConnClosed {
+		t.Fatalf("expected ErrConnClosed, got %v", err)
+	}
+}
+
+func TestClientOrder(t *testing.T) {
+	var fake faker
+	fake.Writer = ioutil.Discard
+	server := "222 1 <a@x>\r\nbody\r\n.\r\n"
+	cl := NewClient(&Conn{conn: fake, w: fake, r: bufio.NewReader(strings.NewReader(server))})
+
+	body, err := cl.Body("<a@x>")
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	// Callers queue up behind the open body, one at a time.
+	const callers = 10
+	var order []int
+	var wg sync.WaitGroup
+	for i := 0; i < callers; i++ {
+		wg.Add(1)
+		go func(i int) {
+			defer wg.Done()
+			cl.Do(func(c *Conn) error {
+				order = append(order, i)
+				return nil
+			})
+		}(i)
+		for {
+			cl.mu.mu.Lock()
+			n := len(cl.mu.waiting)
+			cl.mu.mu.Unlock()
+			if n == i+1 {
+				break
+			}
+			time.Sleep(time.Millisecond)
+		}
+	}
+
+	body.Close()
+	wg.Wait()
+	for i, o := range order {
+		if o != i {
+			t.Fatalf("callers were served out of order: %v", order)
+		}
+	}
+}
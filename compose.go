@@ -0,0 +1,213 @@
+package nntp
+
+import (
+	"errors"
+	"fmt"
+	"io"
+	"regexp"
+	"strings"
+	"time"
+)
+
+// ErrFollowupToPoster is returned by Composer.Followup when the parent
+// article's Followup-To header is "poster": its author asked for replies by
+// mail, which Composer.Reply builds.
+var ErrFollowupToPoster = errors.New("followups are to be mailed to the poster")
+
+// maxReferences is the longest the References line of a followup may be,
+// including the header name (RFC 5537 section 3.4.4).
+const maxReferences = 998
+
+// A Composer builds articles that are ready to post, filling in the headers
+// every article needs.
+type Composer struct {
+	From   string // From header, e.g. "Jane Doe <jane@example.com>"
+	Domain string // Right-hand side of generated message-ids; "nntp.invalid" if empty
+
+	// Now returns the time for Date headers. If nil, time.Now is used.
+	Now func() time.Time
+}
+
+// New returns a new article for the groups in newsgroups, with a fresh
+// Message-ID and the Date, From, Newsgroups and Subject headers set.
+func (c *Composer) New(newsgroups []string, subject string, body io.Reader) (*Article, error) {
+	if len(newsgroups) == 0 {
+		return nil, errors.New("no newsgroups to post to")
+	}
+	a, err := c.article(subject, body)
+	if err != nil {
+		return nil, err
+	}
+	a.Header.Add("Newsgroups", strings.Join(newsgroups, ","))
+	return a, nil
+}
+
+// Followup returns a followup to parent, for posting to the groups in its
+// Followup-To header, or if it has none, those in its Newsgroups. The
+// subject is the parent's with "Re: " put in front, if it isn't already,
+// and the parent's Message-ID is added to its References, which are
+// trimmed as RFC 5537 describes if they grow too long.
+//
+// If the parent's Followup-To is "poster", ErrFollowupToPoster is returned.
+func (c *Composer) Followup(parent *Article, body io.Reader) (*Article, error) {
+	groups := parent.Header.Get("Followup-To")
+	if strings.EqualFold(strings.TrimSpace(groups), "poster") {
+		return nil, ErrFollowupToPoster
+	}
+	if groups == "" {
+		groups = parent.Header.Get("Newsgroups")
+	}
+	groups = strings.Join(strings.Fields(groups), "")
+	if groups == "" {
+		return nil, errors.New("parent article has no Newsgroups header")
+	}
+
+	a, refs, err := c.response(parent, body)
+	if err != nil {
+		return nil, err
+	}
+	a.Header.Add("Newsgroups", groups)
+	a.Header.Add("References", refs)
+	return a, nil
+}
+
+// Reply returns a reply to be mailed to the author of parent, at the
+// address in its Reply-To header, or if it has none, its From. The subject
+// and References are derived as for Followup, and In-Reply-To is set. The
+// reply has no Newsgroups header, so it can't be posted.
+func (c *Composer) Reply(parent *Article, body io.Reader) (*Article, error) {
+	to := parent.Header.Get("Reply-To")
+	if to == "" {
+		to = parent.Header.Get("From")
+	}
+	if to == "" {
+		return nil, errors.New("parent article has no From header")
+	}
+
+	a, refs, err := c.response(parent, body)
+	if err != nil {
+		return nil, err
+	}
+	a.Header.Add("To", to)
+	a.Header.Add("In-Reply-To", parent.Header.Get("Message-Id"))
+	a.Header.Add("References", refs)
+	return a, nil
+}
+
+// article returns an article with the headers common to every kind.
+func (c *Composer) article(subject string, body io.Reader) (*Article, error) {
+	if c.From == "" {
+		return nil, errors.New("composer has no From address")
+	}
+	id, err := newMessageID(c.Domain)
+	if err != nil {
+		return nil, err
+	}
+	now := time.Now
+	if c.Now != nil {
+		now = c.Now
+	}
+	return &Article{
+		Header: Header{
+			{"From", c.From},
+			{"Subject", subject},
+			{"Date", now().Format(time.RFC1123Z)},
+			{"Message-ID", id},
+		},
+		Body: body,
+	}, nil
+}
+
+// response returns an article responding to parent, with its subject, and
+// the References it should have.
+func (c *Composer) response(parent *Article, body io.Reader) (*Article, string, error) {
+	id := parent.Header.Get("Message-Id")
+	if id == "" {
+		return nil, "", errors.New("parent article has no Message-ID header")
+	}
+	refs := trimReferences(append(strings.Fields(parent.Header.Get("References")), id))
+	a, err := c.article(replySubject(parent.DecodedSubject()), body)
+	return a, refs, err
+}
+
+// replyPrefix matches the "Re: " that newsreaders put in front of the
+// subjects of followups, however many times, and in whatever case.
+var replyPrefix = regexp.MustCompile(`^(?i:\s*re\s*:\s*)+`)
+
+// replySubject returns the subject for a response to an article with the
+// given subject: "Re: " and the subject, with any "Re: " it had removed.
+func replySubject(subject string) string {
+	return "Re: " + replyPrefix.ReplaceAllString(subject, "")
+}
+
+// trimReferences joins ids into a References value, removing ids from the
+// second on until the header fits in maxReferences octets. The first id,
+// and the last three, are always kept (RFC 5537 section 3.4.4).
+func trimReferences(ids []string) string {
+	length := len("References:")
+	for _, id := range ids {
+		length += 1 + len(id)
+	}
+	for length > maxReferences && len(ids) > 4 {
+		length -= 1 + len(ids[1])
+		ids = append(ids[:1], ids[2:]...)
+	}
+	return strings.Join(ids, " ")
+}
+
+// singleHeaders are the headers an article may have only one of (RFC 5536
+// section 3).
+var singleHeaders = []string{
+	"Approved", "Archive", "Control", "Date", "Distribution", "Expires",
+	"Followup-To", "From", "Injection-Date", "Injection-Info", "Message-ID",
+	"Newsgroups", "Organization", "Path", "References", "Reply-To",
+	"Sender", "Subject", "Summary", "Supersedes", "User-Agent", "Xref",
+}
+
+// messageID matches a message-id in its angle brackets: printable ASCII
+// other than angle brackets, with one @.
+var messageID = regexp.MustCompile(`^<[!-;=?A-~]+@[!-;=?A-~]+>$`)
+
+// Validate checks that the article can be posted: that it has From,
+// Newsgroups and Subject headers, no more than one of the headers RFC 5536
+// allows only once, header names and values that are well-formed, no
+// non-ASCII text in headers such as Message-ID and References, and a
+// well-formed Message-ID, if it has one. Date, Message-ID and Path may be
+// left for the server to add.
+func (a *Article) Validate() error {
+	for _, key := range []string{"From", "Newsgroups", "Subject"} {
+		if strings.TrimSpace(a.Header.Get(key)) == "" {
+			return fmt.Errorf("article has no %s header", key)
+		}
+	}
+	for _, key := range singleHeaders {
+		if n := len(a.Header.Values(key)); n > 1 {
+			return fmt.Errorf("article has %d %s headers", n, key)
+		}
+	}
+	for _, f := range a.Header {
+		if f.Key == "" || strings.IndexFunc(f.Key, func(r rune) bool { return r <= ' ' || r > '~' || r == ':' }) >= 0 {
+			return fmt.Errorf("malformed header name %q", f.Key)
+		}
+		for i, line := range strings.Split(f.Value, "\n") {
+			if strings.ContainsRune(line, '\r') || (i > 0 && !strings.HasPrefix(line, " ") && !strings.HasPrefix(line, "\t")) {
+				return fmt.Errorf("malformed %s header: %q", f.Key, f.Value)
+			}
+		}
+		if identifierHeaders[strings.ToLower(f.Key)] && !isASCII(f.Value) {
+			return fmt.Errorf("non-ASCII text in %s header: %q", f.Key, f.Value)
+		}
+	}
+	// Groups may be separated by folding whitespace as well as commas
+	// (RFC 5536 section 3.1.4).
+	for _, group := range strings.Split(a.Header.Get("Newsgroups"), ",") {
+		group = strings.TrimSpace(group)
+		if group == "" || strings.IndexFunc(group, func(r rune) bool { return r <= ' ' || r > '~' }) >= 0 {
+			return fmt.Errorf("malformed Newsgroups header: %q", a.Header.Get("Newsgroups"))
+		}
+	}
+	if id := a.Header.Get("Message-Id"); id != "" && !messageID.MatchString(id) {
+		return fmt.Errorf("malformed Message-ID: %q", id)
+	}
+	return nil
+}
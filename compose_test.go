@@ -0,0 +1,123 @@
+package nntp
+
+import (
+	"fmt"
+	"strings"
+	"testing"
+	"time"
+)
+
+func TestComposer(t *testing.T) {
+	c := &Composer{
+		From:   "Jane Doe <jane@example.com>",
+		Domain: "example.com",
+		Now:    func() time.Time { return time.Date(2024, 3, 1, 12, 30, 0, 0, time.UTC) },
+	}
+
+	a, err := c.New([]string{"comp.lang.go", "misc.test"}, "Hello", strings.NewReader("Hi.\n"))
+	if err != nil {
+		t.Fatal(err)
+	}
+	if err := a.Validate(); err != nil {
+		t.Fatal(err)
+	}
+	if a.Header.Get("Newsgroups") != "comp.lang.go,misc.test" || a.Header.Get("Date") != "Fri, 01 Mar 2024 12:30:00 +0000" ||
+		a.Header.Get("From") != c.From || a.Header.Get("Subject") != "Hello" || !strings.HasSuffix(a.Header.Get("Message-ID"), "@example.com>") {
+		t.Fatalf("unexpected header %q", a.Header)
+	}
+	if b, _ := c.New([]string{"misc.test"}, "Hello", nil); b.Header.Get("Message-ID") == a.Header.Get("Message-ID") {
+		t.Fatal("message-ids were reused")
+	}
+
+	parent := &Article{Header: Header{
+		{"From", "Joe <joe@example.org>"},
+		{"Newsgroups", "comp.lang.go, misc.test"},
+		{"Subject", "=?UTF-8?Q?RE:_Re:_caf=C3=A9?="},
+		{"Message-ID", "<3@example.org>"},
+		{"References", "<1@example.org> <2@example.org>"},
+	}}
+	f, err := c.Followup(parent, strings.NewReader("Indeed.\n"))
+	if err != nil {
+		t.Fatal(err)
+	}
+	if err := f.Validate(); err != nil {
+		t.Fatal(err)
+	}
+	if f.Header.Get("Subject") != "Re: café" || f.Header.Get("Newsgroups") != "comp.lang.go,misc.test" ||
+		f.Header.Get("References") != "<1@example.org> <2@example.org> <3@example.org>" {
+		t.Fatalf("unexpected followup header %q", f.Header)
+	}
+
+	parent.Header.Set("Followup-To", "misc.test")
+	if f, err = c.Followup(parent, nil); err != nil || f.Header.Get("Newsgroups") != "misc.test" {
+		t.Fatalf("expected followup to misc.test, got %q, %v", f.Header, err)
+	}
+	parent.Header.Set("Followup-To", "Poster")
+	if _, err = c.Followup(parent, nil); err != ErrFollowupToPoster {
+		t.Fatalf("expected ErrFollowupToPoster, got %v", err)
+	}
+
+	parent.Header.Set("Reply-To", "joe@example.net")
+	r, err := c.Reply(parent, nil)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if r.Header.Get("To") != "joe@example.net" || r.Header.Get("In-Reply-To") != "<3@example.org>" ||
+		r.Header.Get("Newsgroups") != "" || r.Header.Get("Subject") != "Re: café" {
+		t.Fatalf("unexpected reply header %q", r.Header)
+	}
+}
+
+func TestTrimReferences(t *testing.T) {
+	var ids []string
+	for i := 0; i < 100; i++ {
+		ids = append(ids, fmt.Sprintf("<%d.abcdefghijklmnop@example.com>", i))
+	}
+	refs := trimReferences(append([]string(nil), ids...))
+	if len("References: "+refs) > maxReferences {
+		t.Fatalf("References too long: %d", len(refs))
+	}
+	kept := strings.Fields(refs)
+	if kept[0] != ids[0] || strings.Join(kept[len(kept)-3:], " ") != strings.Join(ids[97:], " ") {
+		t.Fatalf("first and last three references not kept: %q", kept)
+	}
+	if short := trimReferences(ids[:5]); short != strings.Join(ids[:5], " ") {
+		t.Fatalf("short References trimmed: %q", short)
+	}
+}
+
+func TestValidate(t *testing.T) {
+	valid := Header{{"From", "a@b.c"}, {"Newsgroups", "misc.test"}, {"Subject", "s"}}
+	tests := []struct {
+		extra Header
+		drop  string
+		ok    bool
+	}{
+		{nil, "", true},
+		{Header{{"Message-ID", "<x@y>"}, {"Received", "a"}, {"Received", "b"}, {"X-Long", "folded\n continued"}}, "", true},
+		{nil, "Newsgroups", false},
+		{nil, "From", false},
+		{Header{{"subject", "again"}}, "", false},
+		{Header{{"Message-ID", "no-brackets@y"}}, "", false},
+		{Header{{"Message-ID", "<café@y>"}}, "", false},
+		{Header{{"References", "<café@y>"}}, "", false},
+		{Header{{"Bad Key", "v"}}, "", false},
+		{Header{{"X-Injected", "v\nNewsgroups: alt.spam"}}, "", false},
+	}
+	for i, tt := range tests {
+		a := &Article{Header: append(append(Header(nil), valid...), tt.extra...)}
+		a.Header.Del(tt.drop)
+		if err := a.Validate(); (err == nil) != tt.ok {
+			t.Errorf("%d: Validate() = %v", i, err)
+		}
+	}
+
+	a := &Article{Header: Header{{"From", "a@b.c"}, {"Newsgroups", "misc.test, alt.test,\n comp.test"}, {"Subject", "s"}}}
+	if err := a.Validate(); err != nil {
+		t.Errorf("whitespace around the commas in Newsgroups should be allowed, got %v", err)
+	}
+	a.Header.Set("Newsgroups", "misc.test, alt test")
+	if err := a.Validate(); err == nil {
+		t.Error("expected an error for a space in a group name")
+	}
+}
@@ -0,0 +1,366 @@
+package nntp
+
+import (
+	"context"
+	"crypto/tls"
+	"io"
+	"net"
+	"time"
+)
+
+// aLongTimeAgo is a non-zero time, far in the past, used to make pending
+// IO on a connection fail immediately.
+var aLongTimeAgo = time.Unix(1, 0)
+
+// A deadliner is a connection whose IO can be bounded in time, such as a
+// net.Conn.
+type deadliner interface {
+	SetDeadline(t time.Time) error
+}
+
+// watch bounds the IO on c by ctx, applying ctx's deadline to the
+// underlying connection and interrupting any pending IO if ctx is
+// cancelled.
+//
+// The returned function must be called with the outcome of the IO once it
+// is complete; it lifts the deadline and returns the error to report. IO
+// that was interrupted leaves the response half-read, so in that case the
+// connection is closed and ctx.Err() is returned instead.
+func (c *Conn) watch(ctx context.Context) (done func(error) error, err error) {
+	if err = ctx.Err(); err != nil {
+		return nil, err
+	}
+
+	// Finish any body still bounded by an earlier context first, so that
+	// its completion doesn't lift the deadline we're about to set.
+	if c.br != nil && c.br.done != nil {
+		if err = c.br.discard(); err != nil {
+			return nil, err
+		}
+	}
+
+	d, ok := c.conn.(deadliner)
+	if !ok || ctx.Done() == nil {
+		return func(err error) error { return err }, nil
+	}
+
+	if t, ok := ctx.Deadline(); ok {
+		d.SetDeadline(t)
+	}
+
+	stop := make(chan struct{})
+	stopped := make(chan struct{})
+	go func() {
+		defer close(stopped)
+		select {
+		case <-ctx.Done():
+			d.SetDeadline(aLongTimeAgo)
+		case <-stop:
+		}
+	}()
+
+	return func(err error) error {
+		close(stop)
+		<-stopped
+		d.SetDeadline(time.Time{})
+
+		if err == nil {
+			return nil
+		}
+		if nerr, ok := err.(net.Error); ctx.Err() == nil && !(ok && nerr.Timeout()) {
+			return err
+		}
+
+		c.fail(err)
+		if ctx.Err() != nil {
+			return ctx.Err()
+		}
+		// The connection deadline can fire a moment before ctx notices.
+		return context.DeadlineExceeded
+	}, nil
+}
+
+// do runs f with its IO bounded by ctx.
+func (c *Conn) do(ctx context.Context, f func() error) error {
+	done, err := c.watch(ctx)
+	if err != nil {
+		return err
+	}
+	return done(f())
+}
+
+// doBody runs f, which starts a response body, with its IO bounded by ctx.
+// ctx continues to bound reads from the body until it is exhausted.
+func (c *Conn) doBody(ctx context.Context, f func() error) error {
+	done, err := c.watch(ctx)
+	if err != nil {
+		return err
+	}
+	if err = f(); err != nil {
+		return done(err)
+	}
+	if c.br == nil || c.br.eof {
+		return done(nil)
+	}
+	c.br.done = done
+	return nil
+}
+
+// AuthenticateContext is like Authenticate, but bounded by ctx.
+func (c *Conn) AuthenticateContext(ctx context.Context, username, password string) error {
+	return c.do(ctx, func() error {
+		return c.Authenticate(username, password)
+	})
+}
+
+// AuthenticateSASLContext is like AuthenticateSASL, but bounded by ctx.
+func (c *Conn) AuthenticateSASLContext(ctx context.Context, m SASL) error {
+	return c.do(ctx, func() error {
+		return c.AuthenticateSASL(m)
+	})
+}
+
+// StartTLSContext is like StartTLS, but the command and the TLS handshake
+// are bounded by ctx.
+func (c *Conn) StartTLSContext(ctx context.Context, config *tls.Config) error {
+	return c.do(ctx, func() error {
+		return c.StartTLS(config)
+	})
+}
+
+// ModeReaderContext is like ModeReader, but bounded by ctx.
+func (c *Conn) ModeReaderContext(ctx context.Context) error {
+	return c.do(ctx, c.ModeReader)
+}
+
+// NewGroupsContext is like NewGroups, but bounded by ctx.
+func (c *Conn) NewGroupsContext(ctx context.Context, since time.Time) (groups []*Group, err error) {
+	err = c.do(ctx, func() error {
+		groups, err = c.NewGroups(since)
+		return err
+	})
+	return
+}
+
+// NewNewsContext is like NewNews, but bounded by ctx.
+func (c *Conn) NewNewsContext(ctx context.Context, group string, since time.Time) (ids []string, err error) {
+	err = c.do(ctx, func() error {
+		ids, err = c.NewNews(group, since)
+		return err
+	})
+	return
+}
+
+// OverviewContext is like Overview, but bounded by ctx.
+func (c *Conn) OverviewContext(ctx context.Context, begin, end int64) (overviews []MessageOverview, err error) {
+	err = c.do(ctx, func() error {
+		overviews, err = c.Overview(begin, end)
+		return err
+	})
+	return
+}
+
+// HdrContext is like Hdr, but bounded by ctx.
+func (c *Conn) HdrContext(ctx context.Context, field string, rng Range) (values []HeaderValue, err error) {
+	err = c.do(ctx, func() error {
+		values, err = c.Hdr(field, rng)
+		return err
+	})
+	return
+}
+
+// HdrIDContext is like HdrID, but bounded by ctx.
+func (c *Conn) HdrIDContext(ctx context.Context, field, id string) (values []HeaderValue, err error) {
+	err = c.do(ctx, func() error {
+		values, err = c.HdrID(field, id)
+		return err
+	})
+	return
+}
+
+// CapabilitiesContext is like Capabilities, but bounded by ctx.
+func (c *Conn) CapabilitiesContext(ctx context.Context) (caps *Capabilities, err error) {
+	err = c.do(ctx, func() error {
+		caps, err = c.Capabilities()
+		return err
+	})
+	return
+}
+
+// ListExtensionsContext is like ListExtensions, but bounded by ctx.
+func (c *Conn) ListExtensionsContext(ctx context.Context) (exts []string, err error) {
+	err = c.do(ctx, func() error {
+		exts, err = c.ListExtensions()
+		return err
+	})
+	return
+}
+
+// DateContext is like Date, but bounded by ctx.
+func (c *Conn) DateContext(ctx context.Context) (t time.Time, err error) {
+	err = c.do(ctx, func() error {
+		t, err = c.Date()
+		return err
+	})
+	return
+}
+
+// EnableCompressionContext is like EnableCompression, but bounded by ctx.
+func (c *Conn) EnableCompressionContext(ctx context.Context) error {
+	return c.do(ctx, c.EnableCompression)
+}
+
+// CompressContext is like Compress, but bounded by ctx.
+func (c *Conn) CompressContext(ctx context.Context) error {
+	return c.do(ctx, c.Compress)
+}
+
+// ListContext is like List, but bounded by ctx.
+func (c *Conn) ListContext(ctx context.Context, a ...string) (groups []*Group, err error) {
+	err = c.do(ctx, func() error {
+		groups, err = c.List(a...)
+		return err
+	})
+	return
+}
+
+// GroupContext is like Group, but bounded by ctx.
+func (c *Conn) GroupContext(ctx context.Context, group string) (status *Group, err error) {
+	err = c.do(ctx, func() error {
+		status, err = c.Group(group)
+		return err
+	})
+	return
+}
+
+// ListGroupContext is like ListGroup, but bounded by ctx.
+func (c *Conn) ListGroupContext(ctx context.Context, group string, from, to int64) (listing *GroupListing, err error) {
+	err = c.do(ctx, func() error {
+		listing, err = c.ListGroup(group, from, to)
+		return err
+	})
+	return
+}
+
+// HelpContext is like Help, but bounded by ctx. ctx also bounds reads from
+// the returned io.ReadCloser.
+func (c *Conn) HelpContext(ctx context.Context) (r io.ReadCloser, err error) {
+	err = c.doBody(ctx, func() error {
+		r, err = c.Help()
+		return err
+	})
+	return
+}
+
+// StatContext is like Stat, but bounded by ctx.
+func (c *Conn) StatContext(ctx context.Context, id string) (number, msgid string, err error) {
+	err = c.do(ctx, func() error {
+		number, msgid, err = c.Stat(id)
+		return err
+	})
+	return
+}
+
+// StatManyContext is like StatMany, but bounded by ctx.
+func (c *Conn) StatManyContext(ctx context.Context, ids []string, window int) (results []StatResult, err error) {
+	err = c.do(ctx, func() error {
+		results, err = c.StatMany(ids, window)
+		return err
+	})
+	return
+}
+
+// LastContext is like Last, but bounded by ctx.
+func (c *Conn) LastContext(ctx context.Context) (number, msgid string, err error) {
+	err = c.do(ctx, func() error {
+		number, msgid, err = c.Last()
+		return err
+	})
+	return
+}
+
+// NextContext is like Next, but bounded by ctx.
+func (c *Conn) NextContext(ctx context.Context) (number, msgid string, err error) {
+	err = c.do(ctx, func() error {
+		number, msgid, err = c.Next()
+		return err
+	})
+	return
+}
+
+// ArticleTextContext is like ArticleText, but bounded by ctx. ctx also
+// bounds reads from the returned io.ReadCloser.
+func (c *Conn) ArticleTextContext(ctx context.Context, id string) (r io.ReadCloser, err error) {
+	err = c.doBody(ctx, func() error {
+		r, err = c.ArticleText(id)
+		return err
+	})
+	return
+}
+
+// ArticleContext is like Article, but bounded by ctx. ctx also bounds reads
+// from the returned article's Body.
+func (c *Conn) ArticleContext(ctx context.Context, id string) (a *Article, err error) {
+	err = c.doBody(ctx, func() error {
+		a, err = c.Article(id)
+		return err
+	})
+	return
+}
+
+// HeadTextContext is like HeadText, but bounded by ctx. ctx also bounds
+// reads from the returned io.ReadCloser.
+func (c *Conn) HeadTextContext(ctx context.Context, id string) (r io.ReadCloser, err error) {
+	err = c.doBody(ctx, func() error {
+		r, err = c.HeadText(id)
+		return err
+	})
+	return
+}
+
+// HeadContext is like Head, but bounded by ctx.
+func (c *Conn) HeadContext(ctx context.Context, id string) (a *Article, err error) {
+	err = c.do(ctx, func() error {
+		a, err = c.Head(id)
+		return err
+	})
+	return
+}
+
+// BodyContext is like Body, but bounded by ctx. ctx also bounds reads from
+// the returned io.ReadCloser.
+func (c *Conn) BodyContext(ctx context.Context, id string) (r io.ReadCloser, err error) {
+	err = c.doBody(ctx, func() error {
+		r, err = c.Body(id)
+		return err
+	})
+	return
+}
+
+// RawPostContext is like RawPost, but bounded by ctx.
+func (c *Conn) RawPostContext(ctx context.Context, r io.Reader) error {
+	return c.do(ctx, func() error {
+		return c.RawPost(r)
+	})
+}
+
+// PostContext is like Post, but bounded by ctx.
+func (c *Conn) PostContext(ctx context.Context, a *Article) error {
+	return c.do(ctx, func() error {
+		return c.Post(a)
+	})
+}
+
+// IHaveContext is like IHave, but bounded by ctx.
+func (c *Conn) IHaveContext(ctx context.Context, msgid string, r io.Reader) (status TransferStatus, err error) {
+	err = c.do(ctx, func() error {
+		status, err = c.IHave(msgid, r)
+		return err
+	})
+	return
+}
+
+// QuitContext is like Quit, but bounded by ctx.
+func (c *Conn) QuitContext(ctx context.Context) error {
+	return c.do(ctx, c.Quit)
+}
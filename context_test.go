@@ -0,0 +1,78 @@
+package nntp
+
+import (
+	"bufio"
+	"context"
+	"io/ioutil"
+	"net"
+	"testing"
+	"time"
+)
+
+// stallingServer greets the client, answers each command with the
+// corresponding entry of responses and then stops responding.
+func stallingServer(t *testing.T, responses ...string) *Conn {
+	client, server := net.Pipe()
+	go func() {
+		defer server.Close()
+		server.Write([]byte("200 hello\r\n"))
+		r := bufio.NewReader(server)
+		for _, resp := range responses {
+			if _, err := r.ReadString('\n'); err != nil {
+				return
+			}
+			server.Write([]byte(resp))
+		}
+		ioutil.ReadAll(r)
+	}()
+
+	conn, err := newConn(context.Background(), client)
+	if err != nil {
+		t.Fatal("greeting shouldn't error: " + err.Error())
+	}
+	return conn
+}
+
+func TestContextDeadline(t *testing.T) {
+	conn := stallingServer(t)
+
+	ctx, cancel := context.WithTimeout(context.Background(), 50*time.Millisecond)
+	defer cancel()
+	if _, err := conn.GroupContext(ctx, "misc.test"); err != context.DeadlineExceeded {
+		t.Fatalf("expected %v from a stalled GROUP, got %v", context.DeadlineExceeded, err)
+	}
+
+	if _, err := conn.Group("misc.test"); err == nil {
+		t.Fatal("a connection interrupted mid-response shouldn't be reused")
+	}
+}
+
+func TestContextCancelBody(t *testing.T) {
+	conn := stallingServer(t, "222 0 <a@b.c> body\r\nfirst line\r\n")
+
+	ctx, cancel := context.WithCancel(context.Background())
+	r, err := conn.BodyContext(ctx, "<a@b.c>")
+	if err != nil {
+		t.Fatal("BODY shouldn't error: " + err.Error())
+	}
+
+	br := bufio.NewReader(r)
+	if line, err := br.ReadString('\n'); err != nil || line != "first line\n" {
+		t.Fatalf("expected the first line of the body, got %q, %v", line, err)
+	}
+
+	time.AfterFunc(10*time.Millisecond, cancel)
+	if _, err := br.ReadString('\n'); err != context.Canceled {
+		t.Fatalf("expected %v from a cancelled body, got %v", context.Canceled, err)
+	}
+}
+
+func TestContextAlreadyDone(t *testing.T) {
+	conn := stallingServer(t)
+
+	ctx, cancel := context.WithCancel(context.Background())
+	cancel()
+	if err := conn.ModeReaderContext(ctx); err != context.Canceled {
+		t.Fatalf("expected %v, got %v", context.Canceled, err)
+	}
+}
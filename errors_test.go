@@ -0,0 +1,63 @@
+package nntp
+
+import (
+	"bufio"
+	"bytes"
+	"errors"
+	"fmt"
+	"strings"
+	"testing"
+)
+
+func TestErrorIs(t *testing.T) {
+	err := fmt.Errorf("fetching: %w", Error{430, "not here"})
+	if !errors.Is(err, ErrNoSuchArticle) {
+		t.Fatalf("expected %v to match ErrNoSuchArticle", err)
+	}
+	if errors.Is(err, ErrNoSuchArticleNumber) {
+		t.Fatalf("expected %v not to match ErrNoSuchArticleNumber", err)
+	}
+	if ErrorCode(err) != 430 {
+		t.Fatalf("expected code 430 through wrapping, got %d", ErrorCode(err))
+	}
+
+	if !ErrAuthRequired.Temporary() || !ErrNoSuchGroup.Temporary() {
+		t.Fatal("expected 4xx errors to be temporary")
+	}
+	if ErrUnknownCommand.Temporary() || ErrServiceUnavailable.Temporary() {
+		t.Fatal("expected 5xx errors not to be temporary")
+	}
+}
+
+func TestProtocolError(t *testing.T) {
+	var cmdbuf bytes.Buffer
+	var fake faker
+	fake.Writer = &cmdbuf
+
+	server := "211 1234 3000234 3002322 misc.test\r\n" +
+		"211 lots misc.test\r\n"
+	conn := &Conn{conn: fake, w: fake, r: bufio.NewReader(strings.NewReader(server))}
+
+	// A malformed response to GROUP.
+	if _, err := conn.Group("misc.test"); err != nil {
+		t.Fatal("Group shouldn't error: " + err.Error())
+	}
+	_, err := conn.Group("misc.test")
+	var perr ProtocolError
+	if !errors.As(err, &perr) || !IsProtocol(fmt.Errorf("wrapped: %w", err)) {
+		t.Fatalf("expected a ProtocolError, got %v", err)
+	}
+	if perr.Line != "lots misc.test" || perr.Command != "GROUP misc.test" {
+		t.Fatalf("unexpected error fields %+v", perr)
+	}
+
+	// Passwords stay out of errors.
+	conn = &Conn{conn: fake, w: fake, r: bufio.NewReader(strings.NewReader("500 What?\r\ngarbage\r\n"))}
+	err = conn.Authenticate("user", "secret")
+	if !errors.As(err, &perr) {
+		t.Fatalf("expected a ProtocolError, got %v", err)
+	}
+	if perr.Line != "garbage" || perr.Command != "AUTHINFO USER" || strings.Contains(err.Error(), "user") {
+		t.Fatalf("unexpected error %v", err)
+	}
+}
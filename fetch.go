@@ -0,0 +1,159 @@
+package nntp
+
+import (
+	"context"
+	"errors"
+	"io"
+	"sort"
+	"sync"
+)
+
+// A Provider is a news server that a Fetcher gets articles from.
+type Provider struct {
+	Name     string
+	Pool     *Pool
+	Priority int // Providers with lower priorities are tried first
+}
+
+// A Fetcher gets articles by message-id from several providers, trying
+// them in order of priority. This suits binary downloads, where a primary
+// provider is backed up by others for articles it lacks.
+//
+// Providers that answer 430 (no such article) are remembered and not asked
+// for that article again. Only the last MaxMisses articles some provider
+// lacked are remembered. Other failures, such as network errors and
+// temporary refusals, are assumed to be transient: the article is sought
+// from the next provider, but the failing one is still tried next time.
+//
+// A Fetcher is safe for concurrent use.
+type Fetcher struct {
+	providers []*Provider
+
+	mu        sync.Mutex
+	misses    map[string]map[*Provider]bool
+	order     []string // message-ids in misses, oldest first
+	maxMisses int
+}
+
+// MaxMisses is the number of articles a Fetcher remembers the providers
+// that lacked.
+const MaxMisses = 100000
+
+// NewFetcher returns a Fetcher that gets articles from providers. Providers
+// with the same priority are tried in the order given.
+func NewFetcher(providers ...*Provider) *Fetcher {
+	ps := append([]*Provider(nil), providers...)
+	sort.SliceStable(ps, func(i, j int) bool {
+		return ps[i].Priority < ps[j].Priority
+	})
+	return &Fetcher{providers: ps, misses: make(map[string]map[*Provider]bool), maxMisses: MaxMisses}
+}
+
+// Body gets the body of the article with the given message-id and calls fn
+// with it. It returns the provider that served the article.
+//
+// The reader passed to fn is only valid until fn returns; what fn leaves
+// unread is discarded before the connection goes back to its pool. If
+// reading it fails because of a network error, the body is sought from the
+// next provider and fn is called again; any other error from fn is
+// returned as is.
+func (f *Fetcher) Body(ctx context.Context, msgid string, fn func(r io.Reader) error) (*Provider, error) {
+	return f.fetch(ctx, msgid, func(c *Conn) error {
+		r, err := c.BodyContext(ctx, msgid)
+		if err != nil {
+			return err
+		}
+		err = fn(r)
+		finish(c, r)
+		return err
+	})
+}
+
+// Article gets the article with the given message-id and calls fn with it,
+// as Body does.
+func (f *Fetcher) Article(ctx context.Context, msgid string, fn func(a *Article) error) (*Provider, error) {
+	return f.fetch(ctx, msgid, func(c *Conn) error {
+		a, err := c.ArticleContext(ctx, msgid)
+		if err != nil {
+			return err
+		}
+		err = fn(a)
+		finish(c, a)
+		return err
+	})
+}
+
+// finish closes the response fn was given, so that the connection is ready
+// for the next command, and no longer bounded by the fetch's context. If
+// that fails, the connection is closed, to be discarded by its pool.
+func finish(c *Conn, r io.Closer) {
+	if err := r.Close(); err != nil && !c.close {
+		c.fail(nil)
+	}
+}
+
+func (f *Fetcher) fetch(ctx context.Context, msgid string, get func(c *Conn) error) (*Provider, error) {
+	// Report why the article couldn't be had, preferring transient
+	// failures, which are worth retrying, over missing articles.
+	var transient error
+	missing := errors.New("no providers to fetch from")
+
+	for _, p := range f.providers {
+		if f.missed(p, msgid) {
+			missing = ErrNoSuchArticle
+			continue
+		}
+
+		c, err := p.Pool.Get(ctx)
+		if err != nil {
+			if ctx.Err() != nil {
+				return nil, ctx.Err()
+			}
+			transient = err
+			continue
+		}
+		err = get(c)
+		broken := c.close
+		p.Pool.Put(c)
+
+		switch {
+		case err == nil:
+			return p, nil
+		case ctx.Err() != nil:
+			return nil, ctx.Err()
+		case ErrorCode(err) == 430:
+			f.miss(p, msgid)
+			missing = err
+		case ErrorCode(err) == 0 && !broken:
+			// fn failed on its own account
+			return p, err
+		default:
+			transient = err
+		}
+	}
+
+	if transient != nil {
+		return nil, transient
+	}
+	return nil, missing
+}
+
+func (f *Fetcher) missed(p *Provider, msgid string) bool {
+	f.mu.Lock()
+	defer f.mu.Unlock()
+	return f.misses[msgid][p]
+}
+
+func (f *Fetcher) miss(p *Provider, msgid string) {
+	f.mu.Lock()
+	defer f.mu.Unlock()
+	if f.misses[msgid] == nil {
+		if len(f.order) >= f.maxMisses {
+			delete(f.misses, f.order[0])
+			f.order = f.order[1:]
+		}
+		f.order = append(f.order, msgid)
+		f.misses[msgid] = make(map[*Provider]bool)
+	}
+	f.misses[msgid][p] = true
+}
@@ -0,0 +1,124 @@
+package nntp
+
+import (
+	"bufio"
+	"context"
+	"io"
+	"io/ioutil"
+	"strings"
+	"sync/atomic"
+	"testing"
+	"time"
+)
+
+func TestFetcher(t *testing.T) {
+	var primaryAsked int32
+	primary, _ := testServer(t, func(cmd string) string {
+		switch cmd {
+		case "BODY <a@b.c>":
+			atomic.AddInt32(&primaryAsked, 1)
+			return "430 No such article\r\n"
+		case "BODY <b@c.d>":
+			return "222 0 <b@c.d>\r\nprimary\r\n.\r\n"
+		case "BODY <c@d.e>":
+			return "436 Try again later\r\n"
+		}
+		return "500 What?\r\n"
+	})
+	backup, _ := testServer(t, func(cmd string) string {
+		switch cmd {
+		case "BODY <a@b.c>", "BODY <b@c.d>":
+			return "222 0 <a@b.c>\r\nbackup\r\n.\r\n"
+		}
+		return "430 No such article\r\n"
+	})
+
+	f := NewFetcher(
+		&Provider{Name: "backup", Pool: NewPool(ServerConfig{Addr: backup}), Priority: 1},
+		&Provider{Name: "primary", Pool: NewPool(ServerConfig{Addr: primary})},
+	)
+
+	fetch := func(msgid string) (string, string, error) {
+		var body []byte
+		p, err := f.Body(context.Background(), msgid, func(r io.Reader) (err error) {
+			body, err = ioutil.ReadAll(r)
+			return
+		})
+		if p == nil {
+			return "", "", err
+		}
+		return p.Name, string(body), err
+	}
+
+	for i := 0; i < 2; i++ {
+		if name, body, err := fetch("<a@b.c>"); err != nil || name != "backup" || body != "backup\n" {
+			t.Fatalf("<a@b.c> should come from the backup, got %q from %q, %v", body, name, err)
+		}
+	}
+	if n := atomic.LoadInt32(&primaryAsked); n != 1 {
+		t.Fatalf("the primary should only be asked once for an article it lacks, was asked %d times", n)
+	}
+
+	if name, body, err := fetch("<b@c.d>"); err != nil || name != "primary" || body != "primary\n" {
+		t.Fatalf("<b@c.d> should come from the primary, got %q from %q, %v", body, name, err)
+	}
+
+	// The temporary failure is more useful than the backup's 430.
+	if _, _, err := fetch("<c@d.e>"); ErrorCode(err) != 436 {
+		t.Fatalf("expected a 436 error, got %v", err)
+	}
+}
+
+func TestFetcherMisses(t *testing.T) {
+	f := NewFetcher()
+	f.maxMisses = 2
+	p := &Provider{}
+	for _, msgid := range []string{"<a@b.c>", "<b@c.d>", "<b@c.d>", "<c@d.e>"} {
+		f.miss(p, msgid)
+	}
+	if f.missed(p, "<a@b.c>") || !f.missed(p, "<b@c.d>") || !f.missed(p, "<c@d.e>") {
+		t.Fatalf("expected only the last 2 misses to be remembered, got %v", f.misses)
+	}
+	if len(f.misses) != 2 || len(f.order) != 2 {
+		t.Fatalf("misses grew past the limit: %v, %q", f.misses, f.order)
+	}
+}
+
+func TestFetcherPartialRead(t *testing.T) {
+	// The body is larger than the connection's buffer, so most of it is
+	// still on the wire when fn returns.
+	long := "222 0 <a@b.c>\r\n" + strings.Repeat("a line of the body\r\n", 5000) + ".\r\n"
+	addr, _ := testServer(t, func(cmd string) string {
+		switch cmd {
+		case "BODY <a@b.c>":
+			return long
+		case "DATE":
+			return "111 20100329034158\r\n"
+		}
+		return "500 What?\r\n"
+	})
+	pool := NewPool(ServerConfig{Addr: addr})
+	defer pool.Close()
+	f := NewFetcher(&Provider{Name: "only", Pool: pool})
+
+	ctx, cancel := context.WithCancel(context.Background())
+	_, err := f.Body(ctx, "<a@b.c>", func(r io.Reader) error {
+		_, err := bufio.NewReader(r).ReadString('\n')
+		return err
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+	cancel()
+	// Give anything still watching ctx time to act on it.
+	time.Sleep(10 * time.Millisecond)
+
+	c, err := pool.Get(context.Background())
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer pool.Put(c)
+	if _, err := c.Date(); err != nil {
+		t.Fatalf("the pooled connection should be usable after a partial read, got %v", err)
+	}
+}
@@ -0,0 +1,120 @@
+package nntp
+
+import (
+	"bufio"
+	"fmt"
+	"strconv"
+	"strings"
+)
+
+// A Range is a range of article numbers from Low to High, inclusive.
+// A negative High means every article from Low onwards.
+type Range struct {
+	Low, High int64
+}
+
+// String formats r the way NNTP commands expect it.
+func (r Range) String() string {
+	switch {
+	case r.High < 0:
+		return fmt.Sprintf("%d-", r.Low)
+	case r.High == r.Low:
+		return fmt.Sprintf("%d", r.Low)
+	default:
+		return fmt.Sprintf("%d-%d", r.Low, r.High)
+	}
+}
+
+// A HeaderValue is the value of a header or metadata item for one article,
+// as returned by HDR.
+type HeaderValue struct {
+	Number int64  // Article number, or 0 if the article was named by message-id
+	Value  string // Header value. Empty if the header is missing.
+}
+
+// Hdr returns the value of the header field for the articles in the
+// current group numbered within rng. field may also name a metadata item
+// such as ":bytes" or ":lines".
+//
+// Servers without HDR are sent the older XHDR command instead.
+func (c *Conn) Hdr(field string, rng Range) ([]HeaderValue, error) {
+	return c.hdr(field, rng.String())
+}
+
+// HdrID returns the value of the header field for the article named by
+// id, which may be a message-id or a message-number.
+func (c *Conn) HdrID(field, id string) ([]HeaderValue, error) {
+	return c.hdr(field, id)
+}
+
+func (c *Conn) hdr(field, arg string) ([]HeaderValue, error) {
+	caps, err := c.capabilities()
+	if err != nil {
+		return nil, err
+	}
+
+	// XHDR has no metadata items, but servers that know of lines and
+	// bytes accept them as plain header names.
+	xfield := strings.TrimPrefix(field, ":")
+
+	var line string
+	var xerr error
+	if caps != nil {
+		if caps.Hdr {
+			_, line, err = c.cmd(225, "HDR %s %s", field, arg)
+		} else {
+			_, line, err = c.cmd(221, "XHDR %s %s", xfield, arg)
+		}
+		if err != nil {
+			return nil, err
+		}
+	} else if _, line, err = c.cmd(225, "HDR %s %s", field, arg); err != nil {
+		if nerr, ok := err.(Error); ok && nerr.Code == 500 {
+			// This could mean that HDR isn't supported.
+			// Attempt XHDR instead.
+			if _, line, xerr = c.cmd(221, "XHDR %s %s", xfield, arg); xerr != nil {
+				// XHDR failed too. Return the original error.
+				return nil, err
+			}
+		} else {
+			return nil, err
+		}
+	}
+
+	var values []HeaderValue
+	err = c.readMultiline(line, func(r *bufio.Reader) (err error) {
+		values, err = parseHdr(r)
+		return
+	})
+	if err != nil {
+		return nil, err
+	}
+	return values, nil
+}
+
+func parseHdr(r *bufio.Reader) ([]HeaderValue, error) {
+	lines, err := readStrings(r)
+	if err != nil {
+		return nil, err
+	}
+
+	values := make([]HeaderValue, 0, len(lines))
+	for _, line := range lines {
+		ss := strings.SplitN(line, " ", 2)
+		var v HeaderValue
+		// XHDR names the article the way it was asked for, so a
+		// lookup by message-id gets the message-id back.
+		if !strings.HasPrefix(ss[0], "<") || !strings.HasSuffix(ss[0], ">") {
+			n, err := strconv.ParseInt(ss[0], 10, 64)
+			if err != nil {
+				return nil, ProtocolError{Msg: "bad article number in header line", Line: line}
+			}
+			v.Number = n
+		}
+		if len(ss) > 1 {
+			v.Value = strings.TrimSpace(ss[1])
+		}
+		values = append(values, v)
+	}
+	return values, nil
+}
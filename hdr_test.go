@@ -0,0 +1,96 @@
+package nntp
+
+import (
+	"bufio"
+	"bytes"
+	"strings"
+	"testing"
+)
+
+func TestHdr(t *testing.T) {
+	var cmdbuf bytes.Buffer
+	var fake faker
+	fake.Writer = &cmdbuf
+
+	conn := &Conn{conn: fake, w: fake, r: bufio.NewReader(strings.NewReader(hdrServer))}
+
+	values, err := conn.Hdr("Subject", Range{3000, 3002})
+	if err != nil {
+		t.Fatal("Hdr shouldn't error: " + err.Error())
+	}
+	expected := []HeaderValue{{3000, "I am just a test article"}, {3001, ""}, {3002, "Re: I am just a test article"}}
+	if len(values) != len(expected) {
+		t.Fatalf("expected %+v, got %+v", expected, values)
+	}
+	for i := range values {
+		if values[i] != expected[i] {
+			t.Fatalf("expected %+v, got %+v", expected, values)
+		}
+	}
+
+	if values, err := conn.Hdr(":bytes", Range{3000, -1}); err != nil {
+		t.Fatal("Hdr shouldn't error: " + err.Error())
+	} else if len(values) != 1 || values[0].Value != "1234" {
+		t.Fatalf("expected a byte count, got %+v", values)
+	}
+
+	if values, err := conn.HdrID("Subject", "<i.am.a.test.article@example.com>"); err != nil {
+		t.Fatal("HdrID shouldn't error: " + err.Error())
+	} else if len(values) != 1 || values[0].Number != 0 {
+		t.Fatalf("expected a single value for article 0, got %+v", values)
+	}
+
+	if actualcmds := cmdbuf.String(); actualcmds != hdrClient {
+		t.Fatalf("Got:\n%s\nExpected\n%s", actualcmds, hdrClient)
+	}
+}
+
+func TestXhdrID(t *testing.T) {
+	var cmdbuf bytes.Buffer
+	var fake faker
+	fake.Writer = &cmdbuf
+
+	server := "500 What?\r\n" +
+		"500 What?\r\n" +
+		"221 Headers follow\r\n" +
+		"<i.am.a.test.article@example.com> I am just a test article\r\n" +
+		".\r\n"
+	conn := &Conn{conn: fake, w: fake, r: bufio.NewReader(strings.NewReader(server))}
+
+	values, err := conn.HdrID("Subject", "<i.am.a.test.article@example.com>")
+	if err != nil {
+		t.Fatal("HdrID shouldn't error: " + err.Error())
+	}
+	if len(values) != 1 || values[0] != (HeaderValue{0, "I am just a test article"}) {
+		t.Fatalf("expected a single value for article 0, got %+v", values)
+	}
+
+	expected := "CAPABILITIES\r\n" +
+		"HDR Subject <i.am.a.test.article@example.com>\r\n" +
+		"XHDR Subject <i.am.a.test.article@example.com>\r\n"
+	if actualcmds := cmdbuf.String(); actualcmds != expected {
+		t.Fatalf("Got:\n%s\nExpected\n%s", actualcmds, expected)
+	}
+}
+
+var hdrServer = "500 What?\r\n" +
+	"500 What?\r\n" +
+	"221 Headers follow\r\n" +
+	"3000 I am just a test article\r\n" +
+	"3001\r\n" +
+	"3002 Re: I am just a test article\r\n" +
+	".\r\n" +
+	"500 What?\r\n" +
+	"221 Headers follow\r\n" +
+	"3000 1234\r\n" +
+	".\r\n" +
+	"225 Headers follow\r\n" +
+	"0 I am just a test article\r\n" +
+	".\r\n"
+
+var hdrClient = "CAPABILITIES\r\n" +
+	"HDR Subject 3000-3002\r\n" +
+	"XHDR Subject 3000-3002\r\n" +
+	"HDR :bytes 3000-\r\n" +
+	"XHDR bytes 3000-\r\n" +
+	"HDR Subject <i.am.a.test.article@example.com>\r\n"
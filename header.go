@@ -0,0 +1,70 @@
+package nntp
+
+import "strings"
+
+// A HeaderField is a single header line of an article.
+type HeaderField struct {
+	Key, Value string
+}
+
+// A Header holds the header lines of an article in the order they appear,
+// keeping each key's case as it was given. Keys are compared without regard
+// to case, and a key may appear more than once.
+type Header []HeaderField
+
+// Get returns the first value for key, or "" if there is none.
+func (h Header) Get(key string) string {
+	for _, f := range h {
+		if strings.EqualFold(f.Key, key) {
+			return f.Value
+		}
+	}
+	return ""
+}
+
+// Values returns every value for key, in order.
+func (h Header) Values(key string) []string {
+	var vs []string
+	for _, f := range h {
+		if strings.EqualFold(f.Key, key) {
+			vs = append(vs, f.Value)
+		}
+	}
+	return vs
+}
+
+// Add appends a line to the header.
+func (h *Header) Add(key, value string) {
+	*h = append(*h, HeaderField{key, value})
+}
+
+// Set replaces the value of the first line for key, and removes any
+// others. If there is no line for key, one is added at the end.
+func (h *Header) Set(key, value string) {
+	set := false
+	fs := (*h)[:0]
+	for _, f := range *h {
+		if strings.EqualFold(f.Key, key) {
+			if set {
+				continue
+			}
+			f.Value, set = value, true
+		}
+		fs = append(fs, f)
+	}
+	*h = fs
+	if !set {
+		h.Add(key, value)
+	}
+}
+
+// Del removes every line for key.
+func (h *Header) Del(key string) {
+	fs := (*h)[:0]
+	for _, f := range *h {
+		if !strings.EqualFold(f.Key, key) {
+			fs = append(fs, f)
+		}
+	}
+	*h = fs
+}
@@ -0,0 +1,42 @@
+package nntp
+
+import (
+	"bytes"
+	"reflect"
+	"testing"
+)
+
+func TestHeader(t *testing.T) {
+	var h Header
+	h.Add("Path", "a!b")
+	h.Add("Subject", "one")
+	h.Add("Received", "x")
+	h.Add("received", "y")
+
+	if v := h.Get("subject"); v != "one" {
+		t.Fatalf("expected subject \"one\", got %q", v)
+	}
+	if v := h.Get("From"); v != "" {
+		t.Fatalf("expected no From, got %q", v)
+	}
+	if vs := h.Values("RECEIVED"); !reflect.DeepEqual(vs, []string{"x", "y"}) {
+		t.Fatalf("unexpected Received values %q", vs)
+	}
+
+	h.Set("Received", "z")
+	h.Set("From", "me")
+	h.Del("PATH")
+	expected := Header{{"Subject", "one"}, {"Received", "z"}, {"From", "me"}}
+	if !reflect.DeepEqual(h, expected) {
+		t.Fatalf("expected %q, got %q", expected, h)
+	}
+
+	var buf bytes.Buffer
+	a := &Article{Header: h, Body: bytes.NewBufferString("Body.\n")}
+	if _, err := a.WriteTo(&buf); err != nil {
+		t.Fatal(err)
+	}
+	if s := buf.String(); s != "Subject: one\nReceived: z\nFrom: me\n\nBody.\n" {
+		t.Fatalf("article written out of order:\n%s", s)
+	}
+}
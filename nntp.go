@@ -10,12 +10,13 @@ import (
 	"bufio"
 	"bytes"
 	"compress/flate"
+	"context"
 	"crypto/tls"
+	"errors"
 	"fmt"
 	"io"
 	"io/ioutil"
 	"net"
-	"net/http"
 	"sort"
 	"strconv"
 	"strings"
@@ -34,9 +35,43 @@ type Error struct {
 	Msg  string
 }
 
+// Errors for common responses, for use with errors.Is. An Error matches
+// one of these if it has the same Code.
+var (
+	ErrServiceDiscontinued = Error{400, "Service discontinued"}
+	ErrNoSuchGroup         = Error{411, "No such newsgroup"}
+	ErrNoGroupSelected     = Error{412, "No newsgroup selected"}
+	ErrNoCurrentArticle    = Error{420, "Current article number is invalid"}
+	ErrNoNextArticle       = Error{421, "No next article in this group"}
+	ErrNoPreviousArticle   = Error{422, "No previous article in this group"}
+	ErrNoSuchArticleNumber = Error{423, "No article with that number"}
+	ErrNoSuchArticle       = Error{430, "No article with that message-id"}
+	ErrPostingNotPermitted = Error{440, "Posting not permitted"}
+	ErrPostingFailed       = Error{441, "Posting failed"}
+	ErrAuthRequired        = Error{480, "Authentication required"}
+	ErrAuthRejected        = Error{481, "Authentication failed"}
+	ErrAuthOutOfSequence   = Error{482, "Authentication commands issued out of sequence"}
+	ErrEncryptionRequired  = Error{483, "Encryption or stronger authentication required"}
+	ErrUnknownCommand      = Error{500, "Unknown command"}
+	ErrSyntax              = Error{501, "Syntax error"}
+	ErrServiceUnavailable  = Error{502, "Service permanently unavailable"}
+)
+
+// ErrConnClosed is returned by a ResilientConn or a Client once it has been
+// closed.
+var ErrConnClosed = errors.New("connection closed")
+
+// ErrStaleReader is returned by reads from a response reader that is no
+// longer valid, because it was closed or a later command was sent.
+var ErrStaleReader = errors.New("read from a response that is over")
+
 // A ProtocolError represents responses from an NNTP server
 // that seem incorrect for NNTP.
-type ProtocolError string
+type ProtocolError struct {
+	Msg     string // What's wrong
+	Line    string // The offending line of the response, if any
+	Command string // The command that drew the response, if known
+}
 
 // A Conn represents a connection to an NNTP server. The connection with
 // an NNTP server is stateful; it keeps track of what group you have
@@ -48,15 +83,40 @@ type ProtocolError string
 // and messages, or a message-number, which is an integer number that is
 // local to the NNTP session and currently selected group.
 //
-// For all methods that return an io.Reader (or an *Article, which contains
-// an io.Reader), that io.Reader is only valid until the next call to a
-// method of Conn.
+// For all methods that return an io.ReadCloser (or an *Article, which
+// contains one), the reader is only valid until it is closed or the next
+// call to a method of Conn; reading it after that returns ErrStaleReader.
+// Closing it lets the connection skip the rest of the response, which it
+// must otherwise read before the next command (see SetDrainLimit).
+//
+// A Conn is not safe for concurrent use by multiple goroutines; use a
+// Client to share one.
 type Conn struct {
-	conn   io.ReadWriteCloser
-	w      io.Writer
-	r      *bufio.Reader
-	br     *bodyReader
-	close  bool
+	conn  io.ReadWriteCloser
+	w     io.Writer
+	r     *bufio.Reader
+	br    *bodyReader
+	close bool
+	host  string // server name, for verifying certificates
+
+	// drainLimit is how much of an unfinished body Close discards before
+	// closing the connection instead, if drainLimited.
+	drainLimit   int64
+	drainLimited bool
+
+	// lastCmd is the most recent command, without any credentials
+	lastCmd string
+
+	// COMPRESS DEFLATE streams, if active
+	zr io.ReadCloser
+	zw *flate.Writer
+
+	caps            *Capabilities
+	capsUnavailable bool // set if the server won't list its capabilities
+
+	trace struct {
+		c2s, s2c io.Writer
+	}
 	quirks struct {
 		xzverUnsupported bool
 		xzverSupported   bool
@@ -77,22 +137,50 @@ type Group struct {
 
 // An Article represents an NNTP article.
 type Article struct {
-	Header map[string][]string
-	Body   io.Reader
+	Header Header
+	// Body is an io.ReadCloser for articles read from a Conn.
+	Body io.Reader
+}
+
+// Close closes the article's Body, if it can be closed.
+func (a *Article) Close() error {
+	if c, ok := a.Body.(io.Closer); ok {
+		return c.Close()
+	}
+	return nil
 }
 
 // A bodyReader satisfies reads by reading from the connection
 // until it finds a line containing just .
 type bodyReader struct {
-	c   *Conn
-	eof bool
-	buf *bytes.Buffer
+	c     *Conn
+	eof   bool
+	stale bool // the response is over; reads fail
+	buf   *bytes.Buffer
+	// done, if set, is called with the error that ends the body.
+	done func(error) error
 }
 
 var dotnl = []byte(".\n")
 var dotdot = []byte("..")
 
 func (r *bodyReader) Read(p []byte) (n int, err error) {
+	if r.stale {
+		return 0, ErrStaleReader
+	}
+	n, err = r.read(p)
+	if err != nil && r.done != nil {
+		if err == io.EOF {
+			r.done(nil)
+		} else {
+			err = r.done(err)
+		}
+		r.done = nil
+	}
+	return
+}
+
+func (r *bodyReader) read(p []byte) (n int, err error) {
 	if r.eof {
 		return 0, io.EOF
 	}
@@ -102,7 +190,11 @@ func (r *bodyReader) Read(p []byte) (n int, err error) {
 	if r.buf.Len() == 0 {
 		b, err := r.c.r.ReadBytes('\n')
 		if err != nil {
-			return 0, err
+			if err == io.EOF {
+				// the body should have ended with a "."
+				err = io.ErrUnexpectedEOF
+			}
+			return 0, r.c.fail(err)
 		}
 		// canonicalize newlines
 		if b[len(b)-2] == '\r' { // crlf->lf
@@ -129,6 +221,59 @@ func (r *bodyReader) discard() error {
 	return err
 }
 
+// Close ends the body, discarding what's left of it, or closing the
+// connection if more is left than the drain limit.
+func (r *bodyReader) Close() error {
+	if r.stale {
+		return nil
+	}
+	c := r.c
+	if c.drainLimited && !r.eof {
+		_, err := io.CopyN(ioutil.Discard, r, c.drainLimit+1)
+		if err == nil {
+			// There's more; give up on the connection.
+			if r.done != nil {
+				r.done(nil)
+				r.done = nil
+			}
+			c.fail(nil)
+			r.stale = true
+			c.br = nil
+			return nil
+		}
+	}
+	return c.endBody()
+}
+
+// endBody reads what's left of the body being read, if any, so that the
+// next response can be read. The body's reader is stale from then on.
+func (c *Conn) endBody() error {
+	br := c.br
+	if br == nil {
+		return nil
+	}
+	c.br = nil
+	err := br.discard()
+	br.stale = true
+	return err
+}
+
+// readCloser joins a reader, such as a bufio.Reader over a body, with the
+// Close of the body.
+type readCloser struct {
+	io.Reader
+	io.Closer
+}
+
+// SetDrainLimit sets how much of an unfinished response the Close method
+// of a response reader reads and throws away, so that the connection can
+// be used again. If more than n bytes are left, the connection is closed
+// instead, which is quicker than reading a large body that isn't wanted.
+// A negative n, the default, means there is no limit.
+func (c *Conn) SetDrainLimit(n int64) {
+	c.drainLimit, c.drainLimited = n, n >= 0
+}
+
 // articleReader satisfies reads by dumping out an article's headers
 // and body.
 type articleReader struct {
@@ -140,10 +285,8 @@ type articleReader struct {
 func (r *articleReader) Read(p []byte) (n int, err error) {
 	if r.headerbuf == nil {
 		buf := new(bytes.Buffer)
-		for k, fv := range r.a.Header {
-			for _, v := range fv {
-				fmt.Fprintf(buf, "%s: %s\n", k, v)
-			}
+		for _, f := range r.a.Header {
+			fmt.Fprintf(buf, "%s: %s\n", f.Key, f.Value)
 		}
 		if r.a.Body != nil {
 			fmt.Fprintf(buf, "\n")
@@ -171,11 +314,11 @@ func (r *articleReader) Read(p []byte) (n int, err error) {
 }
 
 func (a *Article) String() string {
-	id, ok := a.Header["Message-Id"]
-	if !ok {
+	id := a.Header.Get("Message-Id")
+	if id == "" {
 		return "[NNTP article]"
 	}
-	return fmt.Sprintf("[NNTP article %s]", id[0])
+	return fmt.Sprintf("[NNTP article %s]", id)
 }
 
 func (a *Article) WriteTo(w io.Writer) (int64, error) {
@@ -183,25 +326,68 @@ func (a *Article) WriteTo(w io.Writer) (int64, error) {
 }
 
 func IsProtocol(err error) bool {
-	_, ok := err.(ProtocolError)
-	return ok
+	var perr ProtocolError
+	return errors.As(err, &perr)
 }
 
 func ErrorCode(err error) uint {
-	if nntpErr, ok := err.(Error); ok {
+	var nntpErr Error
+	if errors.As(err, &nntpErr) {
 		return nntpErr.Code
 	}
 	return 0
 }
 
 func (p ProtocolError) Error() string {
-	return string(p)
+	s := p.Msg
+	if p.Line != "" {
+		s += fmt.Sprintf(": %+q", p.Line)
+	}
+	if p.Command != "" {
+		s += " in response to " + p.Command
+	}
+	return s
+}
+
+// annotate fills in the command that a ProtocolError arose from, if it
+// doesn't say.
+func (c *Conn) annotate(err error) error {
+	if perr, ok := err.(ProtocolError); ok && perr.Command == "" {
+		perr.Command = c.lastCmd
+		return perr
+	}
+	return err
+}
+
+// inResponseTo sets the command that a ProtocolError arose from to cmd. It
+// is for responses to pipelined commands, which annotate would take to be
+// for the last command sent.
+func inResponseTo(err error, cmd string) error {
+	if perr, ok := err.(ProtocolError); ok {
+		perr.Command = cmd
+		return perr
+	}
+	return err
 }
 
 func (e Error) Error() string {
 	return fmt.Sprintf("%03d %s", e.Code, e.Msg)
 }
 
+// Is reports whether target is an Error with the same code as e, so that
+// errors.Is(err, ErrNoSuchArticle) and the like work.
+func (e Error) Is(target error) bool {
+	t, ok := target.(Error)
+	return ok && t.Code == e.Code
+}
+
+// Temporary reports whether the server reported a failure of a valid
+// command (a 4xx code), which may succeed later or in other circumstances,
+// rather than refusing a command it doesn't know or allow (a 5xx code).
+func (e Error) Temporary() bool {
+	return e.Code/100 == 4
+}
+
 func maybeId(cmd, id string) string {
 	if len(id) > 0 {
 		return cmd + " " + id
@@ -209,15 +395,21 @@ func maybeId(cmd, id string) string {
 	return cmd
 }
 
-func newConn(c net.Conn) (res *Conn, err error) {
+func newConn(ctx context.Context, c net.Conn) (res *Conn, err error) {
 	res = &Conn{
 		conn: c,
 		w:    c,
 		r:    bufio.NewReaderSize(c, 4096),
 	}
 
-	if _, err = res.r.ReadString('\n'); err != nil {
-		return
+	done, err := res.watch(ctx)
+	if err == nil {
+		_, err = res.r.ReadString('\n')
+		err = done(err)
+	}
+	if err != nil {
+		c.Close()
+		return nil, err
 	}
 
 	return
@@ -231,36 +423,74 @@ func newConn(c net.Conn) (res *Conn, err error) {
 //   conn, err := nntp.Dial("tcp", "my.news:nntp")
 //
 func Dial(network, addr string) (*Conn, error) {
-	c, err := net.Dial(network, addr)
+	return DialContext(context.Background(), network, addr)
+}
+
+// DialContext is like Dial, but the connection attempt and the server's
+// greeting are bounded by ctx.
+func DialContext(ctx context.Context, network, addr string) (*Conn, error) {
+	var d net.Dialer
+	c, err := d.DialContext(ctx, network, addr)
+	if err != nil {
+		return nil, err
+	}
+	conn, err := newConn(ctx, c)
 	if err != nil {
 		return nil, err
 	}
-	return newConn(c)
+	conn.host = hostname(addr)
+	return conn, nil
 }
 
 // Same as Dial but handles TLS connections
 func DialTLS(network, addr string, config *tls.Config) (*Conn, error) {
+	return DialTLSContext(context.Background(), network, addr, config)
+}
+
+// DialTLSContext is like DialTLS, but the connection attempt, the TLS
+// handshake and the server's greeting are bounded by ctx.
+func DialTLSContext(ctx context.Context, network, addr string, config *tls.Config) (*Conn, error) {
 	// dial
-	c, err := net.Dial(network, addr)
+	var d net.Dialer
+	c, err := d.DialContext(ctx, network, addr)
 	if err != nil {
 		return nil, err
 	}
-	// handshake TLS
-	c = tls.Client(c, config)
-	if err = c.(*tls.Conn).Handshake(); err != nil {
+	// handshake TLS, checking the certificate against addr's host name
+	host := hostname(addr)
+	tc := tls.Client(c, tlsConfig(config, host))
+	if err = tc.HandshakeContext(ctx); err != nil {
+		c.Close()
 		return nil, err
 	}
-	// should we check cert
-	if config == nil || !config.InsecureSkipVerify {
-		// get host name
-		host := strings.SplitN(addr, ":", 2)
-		// check valid cert for host
-		if err = c.(*tls.Conn).VerifyHostname(host[0]); err != nil {
-			return nil, err
-		}
-	}
 	// return nntp Conn
-	return newConn(c)
+	conn, err := newConn(ctx, tc)
+	if err != nil {
+		return nil, err
+	}
+	conn.host = host
+	return conn, nil
+}
+
+// hostname returns the host part of addr.
+func hostname(addr string) string {
+	if host, _, err := net.SplitHostPort(addr); err == nil {
+		return host
+	}
+	return addr
+}
+
+// tlsConfig returns config, or a copy of it, such that the server's
+// certificate is checked against host unless the caller said otherwise.
+func tlsConfig(config *tls.Config, host string) *tls.Config {
+	if config == nil {
+		config = &tls.Config{}
+	}
+	if config.ServerName == "" && !config.InsecureSkipVerify {
+		config = config.Clone()
+		config.ServerName = host
+	}
+	return config
 }
 
 // Enables tracing, such that future IO gets dumped to the indicated writers,
@@ -269,20 +499,29 @@ func DialTLS(network, addr string, config *tls.Config) (*Conn, error) {
 // This discards the contents of the current server-to-client receive buffer
 // and should therefore not be attempted while any commands are in progress.
 func (c *Conn) Trace(c2s, s2c io.Writer) {
+	c.trace.c2s, c.trace.s2c = c2s, s2c
+
+	// trace what was said, not how it was compressed
+	var r io.Reader = c.conn
+	var w io.Writer = c.conn
+	if c.zw != nil {
+		r, w = c.zr, c.zw
+	}
+
 	if c2s != nil {
-		c.w = io.MultiWriter(c.conn, c2s)
+		c.w = io.MultiWriter(w, c2s)
 	} else {
-		c.w = c.conn
+		c.w = w
 	}
 
 	if s2c != nil {
-		c.r.Reset(io.TeeReader(c.conn, s2c))
+		c.r.Reset(io.TeeReader(r, s2c))
 	} else {
-		c.r.Reset(c.conn)
+		c.r.Reset(r)
 	}
 }
 
-func (c *Conn) body() io.Reader {
+func (c *Conn) body() *bodyReader {
 	c.br = &bodyReader{c: c}
 	return c.br
 }
@@ -314,46 +553,136 @@ func readStrings(r *bufio.Reader) ([]string, error) {
 
 // Authenticate logs in to the NNTP server.
 // It only sends the password if the server requires one.
+//
+// If the server's capabilities show that it only accepts AUTHINFO SASL,
+// the credentials are sent using the PLAIN mechanism instead.
 func (c *Conn) Authenticate(username, password string) error {
+	caps, err := c.capabilities()
+	if err != nil {
+		return err
+	}
+	if caps != nil && caps.Has("AUTHINFO") && !hasFold(caps.AuthInfo, "USER") {
+		if hasFold(caps.AuthInfo, "SASL") && hasFold(caps.SASL, "PLAIN") {
+			return c.AuthenticateSASL(PlainAuth("", username, password))
+		}
+		return UnsupportedError("AUTHINFO USER")
+	}
+
 	code, _, err := c.cmd(2, "AUTHINFO USER %s", username)
 	if code/100 == 3 {
 		_, _, err = c.cmd(2, "AUTHINFO PASS %s", password)
 	}
+	if err == nil {
+		c.forgetCapabilities()
+	}
 	return err
 }
 
+// StartTLS upgrades a plaintext connection to TLS using the STARTTLS
+// command, as defined in RFC 4642. If config does not name a server, the
+// certificate is checked against the host name the Conn was dialed with.
+//
+// If the server refuses, StartTLS returns an Error and the connection
+// remains usable in plaintext. If the handshake fails, the connection is
+// closed.
+func (c *Conn) StartTLS(config *tls.Config) error {
+	if c.zw != nil {
+		return ProtocolError{Msg: "STARTTLS is not allowed once COMPRESS is active"}
+	}
+	nc, ok := c.conn.(net.Conn)
+	if !ok {
+		return ProtocolError{Msg: "STARTTLS requires a network connection"}
+	}
+	if _, _, err := c.cmd(382, "STARTTLS"); err != nil {
+		return err
+	}
+
+	tc := tls.Client(nc, tlsConfig(config, c.host))
+	if err := tc.Handshake(); err != nil {
+		c.conn.Close()
+		c.close = true
+		return err
+	}
+
+	// Anything the server said in plaintext is void from here on.
+	c.conn = tc
+	c.Trace(c.trace.c2s, c.trace.s2c)
+	c.forgetCapabilities()
+	return nil
+}
+
 // cmd executes an NNTP command:
 // It sends the command given by the format and arguments, and then
 // reads the response line. If expectCode > 0, the status code on the
 // response line must match it. 1 digit expectCodes only check the first
 // digit of the status code, etc.
 func (c *Conn) cmd(expectCode uint, format string, args ...interface{}) (code uint, line string, err error) {
+	if err = c.send(format, args...); err != nil {
+		return 0, "", err
+	}
+	return c.response(expectCode)
+}
+
+// send sends the command given by the format and arguments, without
+// waiting for the response. Any unread response body is discarded first.
+func (c *Conn) send(format string, args ...interface{}) error {
 	if c.close {
-		return 0, "", ProtocolError("connection closed")
+		return ProtocolError{Msg: "connection closed"}
+	}
+	if err := c.endBody(); err != nil {
+		return err
 	}
-	if c.br != nil {
-		if err := c.br.discard(); err != nil {
-			return 0, "", err
+	line := fmt.Sprintf(format, args...)
+	c.lastCmd = line
+	if ss := strings.Fields(line); len(ss) > 2 && strings.EqualFold(ss[0], "AUTHINFO") {
+		// keep passwords and SASL responses out of errors
+		n := 2
+		if strings.EqualFold(ss[1], "SASL") {
+			n = 3
 		}
-		c.br = nil
+		c.lastCmd = strings.Join(ss[:n], " ")
 	}
-	if _, err := fmt.Fprintf(c.w, format+"\r\n", args...); err != nil {
-		return 0, "", err
+	if _, err := fmt.Fprintf(c.w, "%s\r\n", line); err != nil {
+		return c.fail(err)
+	}
+	return nil
+}
+
+// fail marks the connection as unusable, because err left the conversation
+// with the server in an unknown state, and returns err.
+func (c *Conn) fail(err error) error {
+	c.conn.Close()
+	c.close = true
+	return err
+}
+
+// response reads a response line, checking its status code against
+// expectCode as described for cmd.
+func (c *Conn) response(expectCode uint) (code uint, line string, err error) {
+	// A compressed command stays in the compressor until flushed.
+	if c.zw != nil {
+		if err = c.zw.Flush(); err != nil {
+			return 0, "", c.fail(err)
+		}
 	}
 	line, err = c.r.ReadString('\n')
 	if err != nil {
-		return 0, "", err
+		return 0, "", c.fail(err)
 	}
 	line = strings.TrimSpace(line)
 	if len(line) < 4 || line[3] != ' ' {
-		return 0, "", ProtocolError(fmt.Sprintf("short response: %+q", line))
+		return 0, "", c.fail(c.annotate(ProtocolError{Msg: "short response", Line: line}))
 	}
 	i, err := strconv.ParseUint(line[0:3], 10, 0)
 	if err != nil {
-		return 0, "", ProtocolError("invalid response code: " + line)
+		return 0, "", c.fail(c.annotate(ProtocolError{Msg: "invalid response code", Line: line}))
 	}
 	code = uint(i)
 	line = line[4:]
+	if code == 400 {
+		// The server is closing the connection.
+		c.fail(nil)
+	}
 	if 1 <= expectCode && expectCode < 10 && code/100 != expectCode ||
 		10 <= expectCode && expectCode < 100 && code/10 != expectCode ||
 		100 <= expectCode && expectCode < 1000 && code != expectCode {
@@ -366,6 +695,9 @@ func (c *Conn) cmd(expectCode uint, format string, args ...interface{}) (code ui
 // is a mode-switching server.
 func (c *Conn) ModeReader() error {
 	_, _, err := c.cmd(20, "MODE READER")
+	if err == nil {
+		c.forgetCapabilities()
+	}
 	return err
 }
 
@@ -380,29 +712,45 @@ func (c *Conn) NewGroups(since time.Time) ([]*Group, error) {
 
 func (c *Conn) readGroups(line string) ([]*Group, error) {
 	var lines []string
-	var err error
-
-	if strings.Contains(line, "[COMPRESS=GZIP]") {
-		zdr, err := newZlibDotResponse(c.r)
-		defer zdr.Close()
+	err := c.readMultiline(line, func(r *bufio.Reader) (err error) {
+		lines, err = readStrings(r)
+		return
+	})
+	if err != nil {
+		return nil, err
+	}
 
-		if err == nil {
-			lines, err = readStrings(zdr.Reader)
-		}
+	groups, err := parseGroups(lines)
+	return groups, c.annotate(err)
+}
 
-		if err == nil {
-			err = zdr.Close()
+// readMultiline calls f with a reader for the multi-line response that
+// follows the response line, decompressing it if need be.
+func (c *Conn) readMultiline(line string, f func(r *bufio.Reader) error) error {
+	// if we're using XFEATURE COMPRESS GZIP, the response line seems to contain this magic string
+	// (I wish I had a spec for this…)
+	// Either way, failing part-way leaves the rest of the response unread.
+	if !strings.Contains(line, "[COMPRESS=GZIP]") {
+		// plain response
+		if err := f(c.r); err != nil {
+			return c.fail(c.annotate(err))
 		}
-
-	} else {
-		lines, err = readStrings(c.r)
+		return nil
 	}
 
+	zdr, err := newZlibDotResponse(c.r)
 	if err != nil {
-		return nil, err
+		return c.fail(err)
 	}
+	defer zdr.Close()
 
-	return parseGroups(lines)
+	if err = f(zdr.Reader); err == nil {
+		err = zdr.Close()
+	}
+	if err != nil {
+		return c.fail(c.annotate(err))
+	}
+	return nil
 }
 
 // NewNews returns a list of the IDs of articles posted
@@ -446,7 +794,13 @@ type MessageOverview struct {
 // Overview returns overviews of all messages in the current group with message number between
 // begin and end, inclusive.
 func (c *Conn) Overview(begin, end int64) ([]MessageOverview, error) {
-	if !c.quirks.xzverUnsupported || c.quirks.xzverSupported {
+	caps, err := c.capabilities()
+	if err != nil {
+		return nil, err
+	}
+
+	// Servers that list their capabilities without XZVER aren't worth asking.
+	if (caps == nil || caps.Has("XZVER")) && (!c.quirks.xzverUnsupported || c.quirks.xzverSupported) {
 		// Try XZVERing: http://helpdesk.astraweb.com/index.php?_m=news&_a=viewnews&newsid=9
 		if _, _, xzerr := c.cmd(224, "XZVER %d-%d", begin, end); xzerr != nil {
 			c.quirks.xzverUnsupported = true
@@ -457,8 +811,17 @@ func (c *Conn) Overview(begin, end int64) ([]MessageOverview, error) {
 	}
 
 	var line string
-	var err, xerr error
-	if _, line, err = c.cmd(224, "OVER %d-%d", begin, end); err != nil {
+	var xerr error
+	if caps != nil {
+		// Servers that predate RFC 3977 lack OVER, but offer XOVER.
+		cmd := "XOVER"
+		if caps.Over {
+			cmd = "OVER"
+		}
+		if _, line, err = c.cmd(224, "%s %d-%d", cmd, begin, end); err != nil {
+			return nil, err
+		}
+	} else if _, line, err = c.cmd(224, "OVER %d-%d", begin, end); err != nil {
 		if nerr, ok := err.(Error); ok && nerr.Code == 500 {
 			// This could mean that OVER isn't supported.
 			// Attempt XOVER instead.
@@ -472,36 +835,23 @@ func (c *Conn) Overview(begin, end int64) ([]MessageOverview, error) {
 		}
 	}
 
-	// if we're using XFEATURE COMPRESS GZIP, the response line seems to contain this magic string
-	// (I wish I had a spec for this…)
-	if strings.Contains(line, "[COMPRESS=GZIP]") {
-		zdr, err := newZlibDotResponse(c.r)
-		defer zdr.Close()
-
-		var msgs []MessageOverview
-		if err == nil {
-			msgs, err = parseOverview(zdr.Reader)
-		}
-
-		if err == nil {
-			err = zdr.Close()
-		}
-
-		if err != nil {
-			return nil, err
-		} else {
-			return msgs, nil
-		}
-
-	} else {
-		// plain response
-		return parseOverview(c.r)
+	var msgs []MessageOverview
+	err = c.readMultiline(line, func(r *bufio.Reader) (err error) {
+		msgs, err = parseOverview(r)
+		return
+	})
+	if err != nil {
+		return nil, err
 	}
+	return msgs, nil
 }
 
 func (c *Conn) parseXzver() (result []MessageOverview, err error) {
 	// XZVER is a yenc stream…
-	yencStream := &yencReader{r: c.r}
+	yencStream, err := NewYencDecoder(c.r)
+	if err != nil {
+		return nil, c.fail(err)
+	}
 	defer yencStream.Close()
 
 	// containing a DEFLATE stream…
@@ -514,12 +864,14 @@ func (c *Conn) parseXzver() (result []MessageOverview, err error) {
 	if err == nil {
 		// …with a dot at the end
 		flateStream.Close()
-		yencStream.Close()
+		if err = yencStream.Close(); err != nil {
+			return nil, c.fail(err)
+		}
 
 		var line string
 		line, err = c.r.ReadString('\n')
 		if err == nil && strings.TrimRight(line, "\r\n") != "." {
-			return nil, fmt.Errorf("unexpected data after XZVER: %q", line)
+			return nil, c.annotate(ProtocolError{Msg: "unexpected data after XZVER", Line: line})
 		}
 	}
 
@@ -549,11 +901,11 @@ func parseOverview(r *bufio.Reader) ([]MessageOverview, error) {
 		overview := MessageOverview{}
 		ss := strings.Split(strings.TrimSpace(line), "\t")
 		if len(ss) < 8 {
-			return nil, ProtocolError("short header listing line: " + line + strconv.Itoa(len(ss)))
+			return nil, ProtocolError{Msg: fmt.Sprintf("short header listing line (%d fields)", len(ss)), Line: line}
 		}
 		overview.MessageNumber, err = strconv.ParseInt(ss[0], 10, 64)
 		if err != nil {
-			return nil, ProtocolError("bad message number '" + ss[0] + "' in line: " + line)
+			return nil, ProtocolError{Msg: fmt.Sprintf("bad message number %q", ss[0]), Line: line}
 		}
 		overview.Subject = ss[1]
 		overview.From = ss[2]
@@ -590,7 +942,7 @@ func parseOverview(r *bufio.Reader) ([]MessageOverview, error) {
 		if ss[7] == "" {
 			overview.Lines = 0 // unspecified
 		} else if overview.Lines, err = strconv.Atoi(ss[7]); err != nil {
-			return nil, ProtocolError(fmt.Sprintf("bad line count %q in line %q (split into %#v)", ss[7], line, ss)) // eww, string formatting
+			return nil, ProtocolError{Msg: fmt.Sprintf("bad line count %q (split into %#v)", ss[7], ss), Line: line}
 		}
 		overview.Extra = append([]string{}, ss[8:]...)
 		result = append(result, overview)
@@ -605,30 +957,21 @@ func parseGroups(lines []string) ([]*Group, error) {
 	for _, line := range lines {
 		ss := strings.SplitN(strings.TrimSpace(line), " ", 4)
 		if len(ss) < 4 {
-			return nil, ProtocolError("short group info line: " + line)
+			return nil, ProtocolError{Msg: "short group info line", Line: line}
 		}
 		high, err := strconv.ParseInt(ss[1], 10, 64)
 		if err != nil {
-			return nil, ProtocolError("bad number in line: " + line)
+			return nil, ProtocolError{Msg: "bad number in group info line", Line: line}
 		}
 		low, err := strconv.ParseInt(ss[2], 10, 64)
 		if err != nil {
-			return nil, ProtocolError("bad number in line: " + line)
+			return nil, ProtocolError{Msg: "bad number in group info line", Line: line}
 		}
 		res = append(res, &Group{Name: ss[0], High: high, Low: low, Status: ss[3]})
 	}
 	return res, nil
 }
 
-// Capabilities returns a list of features this server performs.
-// Not all servers support capabilities.
-func (c *Conn) Capabilities() ([]string, error) {
-	if _, _, err := c.cmd(101, "CAPABILITIES"); err != nil {
-		return nil, err
-	}
-	return readStrings(c.r)
-}
-
 func (c *Conn) ListExtensions() ([]string, error) {
 	if _, _, err := c.cmd(202, "LIST EXTENSIONS"); err != nil {
 		return nil, err
@@ -645,19 +988,50 @@ func (c *Conn) Date() (time.Time, error) {
 	}
 	t, err := time.Parse(timeFormatDate, line)
 	if err != nil {
-		return time.Time{}, ProtocolError("invalid time: " + line)
+		return time.Time{}, c.annotate(ProtocolError{Msg: "invalid time", Line: line})
 	}
 	return t, nil
 }
 
-// Attempt to enable connection-level compression, e.g. XFEATURE COMPRESS GZIP.
+// Attempt to enable connection-level compression: COMPRESS DEFLATE if the
+// server advertises it, or else XFEATURE COMPRESS GZIP.
 // This will fail with an nntp.Error if the server doesn't support it, or some other
 // type of error in case something more severe has occurred.
 func (c *Conn) EnableCompression() error {
-	_, _, err := c.cmd(290, "XFEATURE COMPRESS GZIP")
+	caps, err := c.capabilities()
+	if err != nil {
+		return err
+	}
+	if caps != nil && hasFold(caps.Compress, "DEFLATE") {
+		return c.Compress()
+	}
+	if caps != nil && !caps.HasArg("XFEATURE-COMPRESS", "GZIP") {
+		return UnsupportedError("XFEATURE COMPRESS GZIP")
+	}
+	_, _, err = c.cmd(290, "XFEATURE COMPRESS GZIP")
 	return err
 }
 
+// Compress enables compression of the whole session with COMPRESS DEFLATE,
+// as defined in RFC 8054. Every command and response that follows is
+// compressed, transparently to the caller.
+func (c *Conn) Compress() error {
+	if _, _, err := c.cmd(206, "COMPRESS DEFLATE"); err != nil {
+		return err
+	}
+	if c.r.Buffered() > 0 {
+		return c.fail(c.annotate(ProtocolError{Msg: "unexpected data after COMPRESS response"}))
+	}
+
+	zw, err := flate.NewWriter(c.conn, flate.DefaultCompression)
+	if err != nil {
+		return err
+	}
+	c.zr, c.zw = flate.NewReader(c.conn), zw
+	c.Trace(c.trace.c2s, c.trace.s2c)
+	return nil
+}
+
 // List returns a list of groups present on the server.
 // Valid forms are:
 //
@@ -667,16 +1041,23 @@ func (c *Conn) EnableCompression() error {
 //
 func (c *Conn) List(a ...string) ([]*Group, error) {
 	if len(a) > 2 {
-		return nil, ProtocolError("List only takes up to 2 arguments")
+		return nil, ProtocolError{Msg: "List only takes up to 2 arguments"}
 	}
 	cmd := "LIST"
 	if len(a) > 0 {
+		caps, err := c.capabilities()
+		if err != nil {
+			return nil, err
+		}
+		if caps != nil && len(caps.List) > 0 && !hasFold(caps.List, a[0]) {
+			return nil, UnsupportedError("LIST " + a[0])
+		}
 		cmd += " " + a[0]
 		if len(a) > 1 {
 			cmd += " " + a[1]
 		}
 	}
-	if _, line, err := c.cmd(215, cmd); err != nil {
+	if _, line, err := c.cmd(215, "%s", cmd); err != nil {
 		return nil, err
 	} else {
 		return c.readGroups(line)
@@ -692,15 +1073,16 @@ func (c *Conn) Group(group string) (status *Group, err error) {
 
 	status, err = parseGroupStatus(line)
 	if err != nil {
-		status.Name = group
+		return nil, c.annotate(err)
 	}
+	status.Name = group
 	return
 }
 
 func parseGroupStatus(line string) (status *Group, err error) {
 	ss := strings.SplitN(line, " ", 4) // intentional -- we ignore optional message
 	if len(ss) < 3 {
-		err = ProtocolError("bad group response: " + line)
+		err = ProtocolError{Msg: "bad group response", Line: line}
 		return
 	}
 
@@ -708,7 +1090,7 @@ func parseGroupStatus(line string) (status *Group, err error) {
 	for i, _ := range n {
 		c, e := strconv.ParseInt(ss[i], 10, 64)
 		if e != nil {
-			err = ProtocolError("bad group response: " + line)
+			err = ProtocolError{Msg: "bad group response", Line: line}
 			return
 		}
 		n[i] = c
@@ -733,7 +1115,7 @@ func (c *Conn) ListGroup(group string, from, to int64) (listing *GroupListing, e
 	}
 	fmt.Println(cmd)
 
-	_, line, err := c.cmd(211, cmd)
+	_, line, err := c.cmd(211, "%s", cmd)
 	if err != nil {
 		return
 	}
@@ -742,7 +1124,7 @@ func (c *Conn) ListGroup(group string, from, to int64) (listing *GroupListing, e
 	if len(ss) >= 3 {
 		status, err = parseGroupStatus(line)
 		if err != nil {
-			return
+			return nil, c.annotate(err)
 		}
 		status.Name = group
 	} else {
@@ -779,7 +1161,7 @@ func (c *Conn) ListGroup(group string, from, to int64) (listing *GroupListing, e
 }
 
 // Help returns the server's help text.
-func (c *Conn) Help() (io.Reader, error) {
+func (c *Conn) Help() (io.ReadCloser, error) {
 	if _, _, err := c.cmd(100, "HELP"); err != nil {
 		return nil, err
 	}
@@ -788,13 +1170,13 @@ func (c *Conn) Help() (io.Reader, error) {
 
 // nextLastStat performs the work for NEXT, LAST, and STAT.
 func (c *Conn) nextLastStat(cmd, id string) (string, string, error) {
-	_, line, err := c.cmd(223, maybeId(cmd, id))
+	_, line, err := c.cmd(223, "%s", maybeId(cmd, id))
 	if err != nil {
 		return "", "", err
 	}
 	ss := strings.SplitN(line, " ", 3) // optional comment ignored
 	if len(ss) < 2 {
-		return "", "", ProtocolError("Bad response to " + cmd + ": " + line)
+		return "", "", c.annotate(ProtocolError{Msg: "bad response", Line: line})
 	}
 	return ss[0], ss[1], nil
 }
@@ -817,10 +1199,10 @@ func (c *Conn) Next() (number, msgid string, err error) {
 	return c.nextLastStat("NEXT", "")
 }
 
-// ArticleText returns the article named by id as an io.Reader.
+// ArticleText returns the article named by id as an io.ReadCloser.
 // The article is in plain text format, not NNTP wire format.
-func (c *Conn) ArticleText(id string) (io.Reader, error) {
-	if _, _, err := c.cmd(220, maybeId("ARTICLE", id)); err != nil {
+func (c *Conn) ArticleText(id string) (io.ReadCloser, error) {
+	if _, _, err := c.cmd(220, "%s", maybeId("ARTICLE", id)); err != nil {
 		return nil, err
 	}
 	return c.body(), nil
@@ -828,22 +1210,23 @@ func (c *Conn) ArticleText(id string) (io.Reader, error) {
 
 // Article returns the article named by id as an *Article.
 func (c *Conn) Article(id string) (*Article, error) {
-	if _, _, err := c.cmd(220, maybeId("ARTICLE", id)); err != nil {
+	if _, _, err := c.cmd(220, "%s", maybeId("ARTICLE", id)); err != nil {
 		return nil, err
 	}
-	r := bufio.NewReader(c.body())
+	br := c.body()
+	r := bufio.NewReader(br)
 	res, err := c.readHeader(r)
 	if err != nil {
-		return nil, err
+		return nil, c.annotate(err)
 	}
-	res.Body = r
+	res.Body = readCloser{r, br}
 	return res, nil
 }
 
-// HeadText returns the header for the article named by id as an io.Reader.
+// HeadText returns the header for the article named by id as an io.ReadCloser.
 // The article is in plain text format, not NNTP wire format.
-func (c *Conn) HeadText(id string) (io.Reader, error) {
-	if _, _, err := c.cmd(221, maybeId("HEAD", id)); err != nil {
+func (c *Conn) HeadText(id string) (io.ReadCloser, error) {
+	if _, _, err := c.cmd(221, "%s", maybeId("HEAD", id)); err != nil {
 		return nil, err
 	}
 	return c.body(), nil
@@ -852,15 +1235,16 @@ func (c *Conn) HeadText(id string) (io.Reader, error) {
 // Head returns the header for the article named by id as an *Article.
 // The Body field in the Article is nil.
 func (c *Conn) Head(id string) (*Article, error) {
-	if _, _, err := c.cmd(221, maybeId("HEAD", id)); err != nil {
+	if _, _, err := c.cmd(221, "%s", maybeId("HEAD", id)); err != nil {
 		return nil, err
 	}
-	return c.readHeader(bufio.NewReader(c.body()))
+	res, err := c.readHeader(bufio.NewReader(c.body()))
+	return res, c.annotate(err)
 }
 
-// Body returns the body for the article named by id as an io.Reader.
-func (c *Conn) Body(id string) (io.Reader, error) {
-	if _, _, err := c.cmd(222, maybeId("BODY", id)); err != nil {
+// Body returns the body for the article named by id as an io.ReadCloser.
+func (c *Conn) Body(id string) (io.ReadCloser, error) {
+	if _, _, err := c.cmd(222, "%s", maybeId("BODY", id)); err != nil {
 		return nil, err
 	}
 	return c.body(), nil
@@ -871,6 +1255,19 @@ func (c *Conn) RawPost(r io.Reader) error {
 	if _, _, err := c.cmd(3, "POST"); err != nil {
 		return err
 	}
+	if err := c.writeDotted(r); err != nil {
+		return err
+	}
+	if _, _, err := c.response(240); err != nil {
+		return err
+	}
+	return nil
+}
+
+// writeDotted sends the text read from r as a multi-line data block,
+// escaping leading dots and terminating it with a line containing just a
+// dot.
+func (c *Conn) writeDotted(r io.Reader) error {
 	br := bufio.NewReader(r)
 	eof := false
 	for {
@@ -878,12 +1275,14 @@ func (c *Conn) RawPost(r io.Reader) error {
 		if err == io.EOF {
 			eof = true
 		} else if err != nil {
-			return err
+			return c.fail(err)
 		}
 		if eof && len(line) == 0 {
 			break
 		}
-		if strings.HasSuffix(line, "\n") {
+		if strings.HasSuffix(line, "\r\n") {
+			line = line[0 : len(line)-2]
+		} else if strings.HasSuffix(line, "\n") {
 			line = line[0 : len(line)-1]
 		}
 		var prefix string
@@ -892,21 +1291,33 @@ func (c *Conn) RawPost(r io.Reader) error {
 		}
 		_, err = fmt.Fprintf(c.w, "%s%s\r\n", prefix, line)
 		if err != nil {
-			return err
+			return c.fail(err)
 		}
 		if eof {
 			break
 		}
 	}
 
-	if _, _, err := c.cmd(240, "."); err != nil {
-		return err
+	if _, err := fmt.Fprintf(c.w, ".\r\n"); err != nil {
+		return c.fail(err)
 	}
 	return nil
 }
 
-// Post posts an article to the server.
+// Post posts an article to the server, after checking it with Validate.
+//
+// Header values with non-ASCII text are sent as RFC 2047 encoded-words,
+// folded to fit in 78 columns. If the body is non-ASCII UTF-8 text and the
+// article has no Content-Type, MIME headers declaring it are added. The
+// article itself is left as it was.
 func (c *Conn) Post(a *Article) error {
+	if err := a.Validate(); err != nil {
+		return err
+	}
+	a, err := encodeArticle(a)
+	if err != nil {
+		return err
+	}
 	return c.RawPost(&articleReader{a: a})
 }
 
@@ -1013,14 +1424,13 @@ func readKeyValue(b *bufio.Reader) (key, value string, err error) {
 	return key, value, nil
 
 Malformed:
-	return "", "", ProtocolError("malformed header line: " + string(line))
+	return "", "", ProtocolError{Msg: "malformed header line", Line: string(line)}
 }
 
 // Internal. Parses headers in NNTP articles. Most of this is stolen from the http package,
 // and it should probably be split out into a generic RFC822 header-parsing package.
 func (c *Conn) readHeader(r *bufio.Reader) (res *Article, err error) {
 	res = new(Article)
-	res.Header = make(map[string][]string)
 	for {
 		var key, value string
 		if key, value, err = readKeyValue(r); err != nil {
@@ -1029,18 +1439,10 @@ func (c *Conn) readHeader(r *bufio.Reader) (res *Article, err error) {
 		if key == "" {
 			break
 		}
-		key = http.CanonicalHeaderKey(key)
 		// RFC 3977 says nothing about duplicate keys' values being equivalent to
-		// a single key joined with commas, so we keep all values seperate.
-		oldvalue, present := res.Header[key]
-		if present {
-			sv := make([]string, 0)
-			sv = append(sv, oldvalue...)
-			sv = append(sv, value)
-			res.Header[key] = sv
-		} else {
-			res.Header[key] = []string{value}
-		}
+		// a single key joined with commas, so we keep all values seperate, in
+		// the order and case they came in.
+		res.Header.Add(key, value)
 	}
 	return res, nil
 }
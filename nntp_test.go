@@ -7,9 +7,18 @@ package nntp
 import (
 	"bufio"
 	"bytes"
+	"compress/flate"
+	"context"
+	"crypto/ecdsa"
+	"crypto/elliptic"
+	"crypto/rand"
+	"crypto/tls"
+	"crypto/x509"
 	"fmt"
 	"io"
 	"io/ioutil"
+	"math/big"
+	"net"
 	"strings"
 	"testing"
 	"time"
@@ -112,7 +121,7 @@ Fin.
 	}
 
 	// Test articleReader
-	expectedart := `Message-Id: <b@c.d>
+	expectedart := `Message-ID: <b@c.d>
 
 Body.
 `
@@ -198,6 +207,9 @@ Body.
 
 var basicServer = `101 Capability list:
 VERSION 2
+READER
+LIST ACTIVE NEWSGROUPS
+OVER
 .
 202 Extensions supported:
 HDR
@@ -248,7 +260,6 @@ Fin.
 .
 231 New newsgroups follow
 .
-500 Not supported
 224 Overview information for 10-11 follows
 10	Subject10	Author <author@server>	Sat, 18 Oct 2003 18:00:00 +0030	<d@e.f>		1000	9
 11	Subject11		18 Oct 2003 19:00:00 +0030	<e@f.g>	<d@e.f> <a@b.c>	2000	18	Extra stuff
@@ -273,7 +284,6 @@ HEAD 101
 BODY 1
 NEWNEWS gmane.comp.lang.go.general 20100301 000000 GMT
 NEWGROUPS 20100301 000000 GMT
-XZVER 10-11
 OVER 10-11
 QUIT
 `
@@ -334,6 +344,7 @@ func TestHacks(t *testing.T) {
 }
 
 var hackServer = `211 6117 53009 59125 uk.politics.drugs
+500 What?
 500 Not supported
 500 What?
 224 data follows 
@@ -350,6 +361,7 @@ var hackServer = `211 6117 53009 59125 uk.politics.drugs
 `
 
 var hackClient = `GROUP uk.politics.drugs
+CAPABILITIES
 XZVER 55010-55010
 OVER 55010-55010
 XOVER 55010-55010
@@ -383,15 +395,15 @@ func TestXzver(t *testing.T) {
 	}
 }
 
-var xzverClient = "GROUP alt.battlestar-galactica\r\nXZVER 160000-160100\r\n"
-var xzverServer = "211 26503 152413 178915 alt.battlestar-galactica\r\n224 compressed data follows\r\n=ybegin line=128 size=-1\r\n\x17\xa7\x93\x9d\x05r\xdc\x17\x91\b\xb2%IZ8wv!\xe6u0\x94\xed\xcc=J\x17\xcbN\x85\x88\x0fo\xbc\x01YG\x82s\x9a+r*\xceV)$\xa14R\xbc\xbavrNK!\x9c\xedGGW\xe1\xac0\xf3\xd4\xd6\xd6\xf6\xbdQy<m\xf1i\xd7\x1d=Jze\xa7\x1dsewM=I\xe3=@\x04\x97\x17\x02\x1b\xdf\x01\xeb\u007f\xd0\xc7e\xa3>!\xddx\xd1\xcdc\xdb\u06478\xac\x82(Q7(\xbf\x93\xf1A\r\ny\x85\xb9\xf8Fyex-\x99\x1er\x15xFYI.\xb5NGQ\x9b\x19\x04CNs\xf1uP\xe9\xdfX\xc6&\xa9^\xec\xdf\xfd\xea\xff\xd2\xd8\x05D;\xb1\xfeb\x0ev\x95\x15,\x19=I7\u042bI\xa5I\xed\x99\xf0\x16\x8d\xa8\xbe\xe8\xb9\xe9zi\xa8)\v\xf5\x19'\xe3Y)\x06\xf5\x10\xfdJ\xb7\x1b\xab\xf41\xa0\xb7o\f\xf0=@\x13\x82\xc9\xf9\xb6!\xcea\xb3&x>%\xfbL\x1c\x11\xf8\xe2=}1#\x99\xc4\r\n\xa6\xb7\x19\x1b\xfd\x92\x98x\xcc\xe4\x01\xb6\\\xe6\xeb\xd1\v\x16\x9c \xb86=I\u007f\b\xa2\"\u0426=M\xa8\xfe\xa1\x80\x19\u055f\xe3\x97\u016e<'jiJ0[\xee\xab\x19\x14\x8b:$\xa0\u0662\xfb\xe1J\xf01\x16\xf6q\xa2\xa5'W\xe8}\"\x17\x13\xb5V\x94\xf9\u0579\xb8\x1e\x81q\"\x11=@\xa1T\xc9wwqg\x05\xce\xdf\xf9\x98\x9b\x8cDW}\x99\xa7t\xad\x1az\xbd\x19\x06\xa0\u0368\xf1\xf3\x1c\xfe\xe34\x86\xe3\r\np\xc4]\xf8e\u063d\x11=MJ\xf5\xc7\xde\a\xa1\xf0\x02\xc6\xf2\x9d8s\xdb\x11D\xe0\xd6=M\u03a3=@\xf3\xecx\xe8b\x8e\xb6\x9dt=@\xe9\xef\u0672XR\xe1\x1f)(\xd9\f\x99\xba\xectH\x8b\x19\xd9_I\xf9v\xbd\x15\xea\x01\xda\a\x04\x8a\x18\x94\x18f\xc1P<\xf8}&P\xef\u067d\xa3\xa9\xaa\xb1\xa1d\xd7q\xf9\u01c2e\xb0\x9f\xeesY\xc5d\xbd\xa6=J\xee\xcdx\xe0\xebT\u04a8\xf2R\b@\x80K\r\n\xd7\u0702\x96\x14ZA\xb5w/M1\xe8\xad\xdf8\xe8\x89\x15\f\xbf\xb0\x11\xfb\xda\x06@\x8b=@X\x87\xdb\xf4\xb0\xf9\xed\x0f8\x14!\xe7\xfe\xdc\x0fu\xd3\xcap\x17%\x95\a\xf5\xef\xf3\xa2\x06\xdb7\xb5\x041\u0273\xfb[/\u190b\u029d\xc2L\x96`\x9a\xdc\x15\xb4;\xc7B\x01\xa8\x16\xc5\xf9\b\xa6C\xc7X\x98\x82%\xf1\xfc\x12\xd0s\xc01\xa81\xc5\x13\f\xfa\x87o\xa8h\"\xd7\xef7}\xdev\xdd\xeb\xd0=}\u07ff\r\n\xde\x02\xf4\xa3\x16\xee\x03?x\xdc\xd9\xf7\x10\x02\xfaR\xb3]\xd7\xd7'\xbcO\x84\x12\xceb\x17\x01\x81\xf8\x1f\xd9\xd7\u074a\xb6\xadG\xb6\a\x8af\xa0\xa4\x9dYTx\x01!q\x84\x98\xc8b\x0e\x86%\xa9\x9c\x1d\u0789\b\x89\xc8&\x04\x14\xfcK\x85\x1e\x93Q\x1ed\xee\x16\xba\x88>\x11ki\x9f\x1c\x8aF\xeap\xff\x83X\xf8\xbe\x930\x82\x90p3gZ\x9e]\x1a\xe3\x15\x1f\xe6o#\x88\xaeC\x80\xb5`[\xa1\xd4\xe8cU\xe8#\r\n36|DX@C=IS\xff$k\\3\x1c\x1e\x84ul&h\xf4^a\xfaP\xb8I\x92\xa3\u0307O\x13\x12i\xe5\x03Z\u0471d\xe7\x8b\xed\xc8O\x97B8=M\xd8m\a\xb7\xbc\xc1\x02(\xc1=I\x88\x90\xeb\x95'\x92\u06e0\xac-\x11\x8d\v.a\u00ef\x9cE\xec\xda5UO\xa1\x83\x1319H\x13'\x0eR]\xf9\xf1\x1d\xa8\xef\x03W\xf6\xd2 7\x84\xf6`\x17\xc0kD\x02G}\xd5\xf9\xb5\xff\xe9\x9a\x10\x8d\r\n\x17\\\x149\xde\x1d\x8a<v\x06JW\x06\xae\xc5y\xa4\xa3Z\u00f8\xadF\xb0\x82\xe2\u0689\xb8}\x86m\xc1\xf5\x89($\xe7\xfb;\xa8\xb2\xff\xb0\xfb\u07df\xa2\u07c0!\x8e\xab\xa5=M\x9c\xa4\u03e9c'\xbc\u007f\xa7\x18,=I\x86\bM\xc5\xc0\u069eIb\xd7('u\x82\x02\xd4Mn\xe3\xc7p\xe7\xb9=@\xe4\x0f\x94\xe1\xdb\x04\x19\xa8\u0241y#\x87\xd5\xd7\xe0\xfd\xdcg\x1f8\xd6=@i\xdfbaD\x986\x81EM\r\n\x84I1x\xd8\xeb\x1c\xb9&s\xbed\x8d&\x9eP8\xd8\"\xfa\xbb(F\xa9\xb2\xc7s\x1a\xc9=@\xaf\xc1\x0e\xe3 \\\x1b0\x84AA\xab\xc9p\b\xcaa\x9a\v>\x94\x18\xa3\x169i\xee\xe7\xcc \x91#\xa1\x04\xb9y\xb6\xbd\xbd\xfd8\v\xc6=}p\xe2x\x8f\x82z\b\xcb\x15\xf1\u07da-\xbb\x80=}\xf5=}\x145D%\x88\x88\xa5\x85O,F\x13-T\x80#+\x80#\x16!\x16\xb0O\x06\x95\xbf\x85\x88\x94\xa9\xea\r\n'\xcdV\x9d\xc5\x15\xc2D0\x99\xdbP(\x04z%\x90@% \x02\xc3\xee)\"\xf1i\xde\u07557A\x80\xba\xc1)o\x96\xfb\x93\v Db\x81M.A\xeb\u007f\xc2\xf6\x8diu\u031b\x99\xba\x0e=}QD\x01COf\xb0\xa7rW\x96\xb9O\xc7pi`\xc7\xeeers\xa0j\xa4x\xbe.\xc7N\x17)=@\x14\xc8\xe7\xa7\x04\u02c6\x01\xe3;4\xf5\xfeG\u02c5\xec\xe0FU\xdatmw\xd0\x1a~=MR8~\r\n\xe6Sw\x82\xd1Z\x90\u01da\xb0=J\xfa\xfc\xf7 \x8dQ6\xd9\xf9\xf9hf\u0225\xcdH\xa8x\x0e\xe7\xb1=J\x1at\x86J\x18\xde=I\xe9\xa9\x1f\f\xfd{\xff\x01\u044b\x13\xb4\x99\xef\u01f5\v;,6f\xbd\x1b\xad\x15\xbe\x9f2\u0757=@\xb5\xf1\xfdra\xd6x\x16$\x88Q\x9d\xe7\f5\x95\x1c5\x83Sg\x1a\xeeF\x89\x92\x14\xb7]\u037d\x0f\xa8\xe3D\xbe\x03\xcc\x03\b\u0094\x99\xd6g\x19\xfc\xa7\xb9\xbe\xfa\xf5\xae\r\n\xcbb|\u00f3\x1f%=J\xc6\x1d\xf9\xe6\u01c7\u007f\xd8\xdc\v\xc7q\x14\u0663\xef\xd4\xcd\xdf\u007f\xbe\xea\xc2o\x84\x86^\xe2\xb7W\u07e9\xe0\x04\xe9o^\xb0\xa9\xf9\xd0k*\xd9\x18\xe2\xf3F\x11U2\xba\x1e\x93\xb8\x80\u0407\xf3\uced1\x17m:!;\x05\xa1A\xef\xf9\b\x87Q\xb6I4&U\xc08\x1a\x13=J\xd3\u0788\a\x0e \xeb^_d\xc0\u0682Y\xae\x1b<\x98\xa1\xec\u068c\xe6\xd7\xd8\x15]\xa9\xf4\u046b\xad:\r\n\xef\\Q\xb6\xfa\xe3\x8bO=}9\xd1\xf5\x9d#\xacO\xcc\xf1\xc7\xd9\xf4\xfc)\x13W\xb9/U\xb8/\xed\xaf{\xa1T\xc0\xf1=}\x13\xc5s\xc2S\x9d\u0160\xa3\xba\x99\xc6\xdf@[\x9blr\xbd?\xe0\xf5?P\x8f\x8c\xe7\x0f\xff\xe4\xd1Q=}\xb2\xe3\x8fD\xe7\x1e\x8eX\x85>\u0236\x80g\x03\x98\x01\xd87\xd1\xf2!aZ\xcc\\p|\x90\xb6_\v\b8\x83Kc\xfe\xf3K\xdd\x1bO\x81\x83K\u05ac\xc1+\xbc\x83\u026c\r\n)w=Md\x97\xf9R=@J\xfe\x96\x03p\xad\xb7}#KS\x1d\xedI\xc5\x14\x8d}\x13\u02fa\xc0\x85\x03~m\x10\xaa~J1\xa6\xba~)Y??W\xe1\x9f\x01Z\xbaLn\x98[\xd5\xeb\xe0\xd4\x0e\xc1\xbe\xf3\x99\xf7\xa3\x06d\xf7\xf1\xf03\xc3=I\bt\x1dc[@Gya\x18\xf8\x1d\xb7\xe9\x87\xc0\xdf\x06\xe0\x87\x9dt\x90\xf2\xd6\xc3`\x02`\xbf_\xbd\\\x95\b\x1c@\x03ck\ud234\xf4w\xe7\xe4S\x9a\x81\r\n\x15\xd1ydn\x12\xaa\xdc\x14<\xde\u007f8\xe2\u007fh\x81.\xd0\xc5\x18Ql\xab\x19=J\xe4\x02E\xf8NT\xbb\xef\u05e7+\xda\xfdo\xda\xd7s\xd2S\x87n\xeb\u007f\x15\x93\xbe+\x99\xfc\x88c\x04c<\x01j\x15p=M.\xa2j\xb0\xa6]\xbb\xaa\x0e\"\x04u>\xd8&Gzw\xf3KK\xb1\x06\xe4\x93\x18\xc9\f\xf6hh\xd0q&\xaeHG\xbd\x8d\u0726\x8a\x0f\xa3\x88\xf9<GN-\xb2\xa4\x1a}\u1a55\xeba\xdd@\r\n\x95\x1aO\u007f\\u\xf4\x8e \xa2\xaf\x17\x94y\xc1\x02\x98\xe5'LC\xee\x04Y\xb9\x88Q\x04\xbd\xd9{(2\x8bG\x1cKJV\xe9\xe0H\xe7.\x9cI\xd0j\xb0y0\xaa\xab%3:\x03\xfb\u007f\x0e\xb7\xac\xbe\xe2\xe9\xc1\xc9\xe6\xb1\x0e\xee-\xb4\x86-\x1b+\xdfQ\x1e\xba\xf3\xee\xd32\xec\x97=M\xd3I1=I\x16\xfa\xf2i\xcea\x1aN\x91-\xa2\xb8\xfax\fyA\xc1#\x11\xe1y\xad\x01\x19T*\x81w\xfc\x1ds\f\xce\r\n\x19\xb8\fA\u0647\x1c\u6267\xe7{hi*\xaagj0`|\xe37\xe1\x1e@\x95\xdc\xee\xf0D*\xd9\xea\f=@$\xe14@qT\u048c\xe6\\moOn\u0357\xec\x04\u0497\x86\xe6\xa7\x1d&\xff\xbd\x1d5\xbe~\xc8\xf1\xaa|x\xcdo*\xe65:T|\xef=@\x83^4\u0791\xf8B\xcaTV_w\xd8\x18\u063d\xdam\xec8\xe36\xce;=J\x93\xe9\xe6\xe7\xe2.\u0494\xb4\xba2y\x12\x97\xbdC\xd6\xf7\r\n-T\x04@\u076c`\x1d\xa7\al\xb6\xd7\x05X\x19\x1f\xc3\aq\xf2\xd5y\xfd\xd2\xfdy\xbc(B\xea\x95\\\xc9X5R\xb6\x82\xeb\xbe\x11\xb3\xb2\\\x8d[u6\\\xc4\x1b\xdbs\x10V\xc3\xfb\x8e\xeb=M\x9b\xe64\u0745\xbe}\xe5O\x18p\u0201\x0f\xae\x0f4+\xf0\xe9\xdd.k\xf6\x1f\xd4\xd8\x01\xc4W\xad\xf1\\\xe5\xa6\u0213\x89FF\x10,\xb8\xfc\xb4\xb6I/\xd1Vkk\xebs%k{\x9c\xec)\xe3\x1c\xa9\x18]\x88\r\nmX\u03fa\xef\xaf\xcc\x0e>\xa7h\xab\x9b\xa3'od\u0751\u007f\xd2Z\x81\x8a\x12\xf7\xd6\xca\xf5wt\xed\xea9\x04\x8b\xf2\xad`\xa16\xfb\xa07\x10\xdf3\x8blB\xb0\x93\x04k\xfa\xc5z\x84V$\xecG\xa9\xe9\x12\x84!\xb3\xb9-\x1dS\"\x02\xe1\b\xc6H\x89\u007f6d\x9da\x90'6\xd2\"\xbe%N\u00a2\xb0\xc7\xc3}\a\xf7\x1c(z\xb6\\\x18\xf9fes\xcck\x8f\xbdRV\xc9`[(\xb4\u00c1`\x0e%\u013a\r\nJ\xf5j\xdbvKY0x\xb4G\xa3\x9e\xc6*pyI\x93IF\xa2\xc6R\x94\xe1{\xb7\xb4\x1c.\xff\xca\u03aa\x05\xad\x02\xa9RY.qNa\xdc\x1al\x80\xb8\xb7\x1e\xf3\xdf\xc2ih\xe3\u06fb\xf3\x9a\xed\xcc\xe0\xe4\xf8U\xbe&h)\xa8\xa6&o\xa9\xa1-\xa4C\xea\x05r\xb6\"\xf0\xf0\x95\x87ehp\x1c\xf47l8\x90c\xbaA\xfdZ\xb4\xde[6\xb2\b\xb3F)\xe1@\xdbV\b\xcc7\\p\x8c\x99f5\x9e'\r\n\x05\xff\xd3|\xebF\xd4K\u0712%QVg\xf4\xe0U\xe2'\xfc+4\x1d\xa8 `\xc6Q'Eu\xe9\xa2\xc2\xcfi$T\xc1\b<\x16J\xc8EG\x8b\u0114\vSSA\xc8\x14r\xa3\xc4\x02f\xff\x01u\x86\u06e3\xe8(\x17\x94\v\u007f\xb2k\xf5\xd6\xc0\x11-\x17,\x8f\xe7\xec=}W\xb5\xd6\x15\xb5B\x9f^\x9f/=My,\xafP~\xc3\xd03\u01a8\xe5\x0f%=M\xc8\x19\xee\x8dQ\x18&E\x01\xd7 \xf5\x8d~\xcd\r\n\x88c\xdbAc\x9b\x1e\x95\u0544OY\xad9\x90\x92y\xf9X\x85\x87\"Cxcji~>s\x11#\x05\xb5\xb9\xf9Yy\u0207\x9ex\xf8\xf8*\x80\xa8d\xc5\xeb\u07ce5\x81.\xb6\u007f\x19\xf4\x03\xe1\x1c>\x06\xd6\xca\xcdd\xb8\xe5l\xd1\xe0\x9e\x8a\xe0\xfb\x10\x069\x05\xc0\v<\x06SK5\xa6\xb5\xa5\x9a\x92\u7c82\x14<3\xe9!\x91Wx\x8b\x1e\x90\xadB\u111a\xd8V\xe9\x02\xda&T\"\u02cf\x98$mU\xa22\r\n#\xf6\x1c\u0302\xd1\xd0f\x82=@\xc0\xd7$\xac\xf6\x89\u07ac\xe1-\xe6\u049f!-\x80C\u0763u^j&\x13\xfc\xd9\xd3N\x1f\xb9\xe9|\xd6m\xee\x98\x14t\xa3M\xf5\xb2\xebQ\x1c\x02\xe5\xa2e\xd5@\x96| J\x81\xf4g\xa87\xdf_TY(\x06\x15qX[,Q\xb6\x86`A\xfd\xa6\x14$'\xcb\xf7F;\u047f\xb5\u025b\xe7\xaf*\x97\x19\x16\xb3\xdc\x1f\xa3\xd5\xc6\xectV\u672c\xd4Xm\xf5\xc6\x1e\xb8\xe4\xf6\r\n\xed\x8eE\x9a\xaa$\x14\u0723\xbd\f\u0221i\xe5\xeb\x1e\x9f\xa2\x8d}\xbcw~\xc7\xe9\x9b#\x90/~=}\xea\x0e`\xac\u007f\xbd\x97,\xd4\xdf\x18\xa2\xde*~h:\x9d\x8d`\xfc1'\xa6v\x16\xb3a\xb96\xbb\xb3\x9e\xc0\x13\xf6=MUU\xfb\xe4\xd5\u045ckS\xe9-(\xe0\xe3\a\xac\xc5\a=I\xc6\xc0a\xcf\xe2\u0662!\x8apL\x80\xcf=@\x95-\xf0=J\xb8\u8e2c\xd9\x037M\xc3\xe7\x04\xa2\x9c)t_\xe8\r\n\xd1\xfd\\\xfa\x8e\xcc6^W\xa8e\xfa\xce6\xb2<\x95*\x1b\xfc\x0f_\x84\x8c2i(*u\xee\x8a\x13\u007f\x1d:\x93%\xb1\xd9\x1b\xf5&\a\x92\xa0\u0350\xb1R\x1c\xe5\xea\xa5!-6\x98\xb0\v0\x86\x8c\xf2\xbc\xc51\xe8\x82Wa\x99\xe3\x17\xe0\x8d\x9d\xf9o\xcf=@\x0fx{\x04\x9f\xf5\xb4\xfa\xf7\xfcXlv\xfe\x9cD\xe3\x02\xcf=MV\v\xac\xa7\xe3\xc0\x99k\xf06\f\x86%<\u03b3ped\xbdC\x9b\x11?:\xc5\r\n\xbc\xe4>\x92\xf1\x8a#\xb7\x8eb3\x1aU\xf84\xfc\x87,\xc8A\x0f\xe9\xc5!~\xef\v\x95\u04df\xf2\u007f\xdaCg\xc1?e\x94\xc31\xads\xb1\xca\u0420\xbf$\xe8\xd1\xfdxi\xb84\xeao|V\x85\xa9D7\xdd\x0e\x80\\\xf3\xd7&'P\xe1\xbc\xc0~\xd3\xc5\xfb\x98u\xc0&\xc4G\xc6\xf7\x92oO\x99\x85\b\xbb\x9e\x13(\xc0\x1f\x1b\xd7\x15\u0579\xb8\xc7A\xf3\xa3\xaew-\u023b\xee\xedN5\xec\xde\x11N\x9ff0\x16B\r\n\xbd\xcap\xc3H6\b@_\x80\xf8V\xc7F\x82M\xdd-\xea\xb2\xcb\xf4D\x12=J1\xa6ql&\x1d\x15\xd6\xd2M\xc9g\xbb`0\x94\xd1o9\xd8q\xaat\xebj\xaf\xd7\vM\xb57I\u392f\x9b\xd4\xc5\x1f\x81B\xff{\\k\x90\xe2)?\xd0\xfagF#L\xf5\xa8r\xf0\xc4\xd6\x01\x80B{kH\xebS8\xd1\x19\x88\x03\u0353\xffS\xf8~4':\xb6_\xe47\u0256\xf9tq\u00c7\x96\x81\xba/\x035\x93l\r\n\xad\x84@\u007f\u03df\xba\xb5\xa8\xee\xf0\xaa\xdd\xda\xcc\u053fE\xdd\xea\xf0&(\x90\x84/\xe1)hEm\xb1!\x81\x0f\xea\x8fq\xe2\x01J\u0382L\xe0\xd6.p\xa0\xf9\xf6!=M-\vBK\x8d\x85\xab\xaa\x0f\x98\xcf\x02\xd7 \x15\u03b4\x10\b\xe7\x85w\"j\x05\xfa\x9f \xf6\xd1\xff\xc3\x044\x9e\xcan\xaa\xd8\xfcJ'\x8d4\xf7\x8f4\x84\xe3p\x1d\xf0\xa9\x84s4h\xe2\x11\x1e=@O@\x06\"E2\xe2\xaa\xfe\x93\x13\xf8\r\n40\xf4\x9e\x0e\xf5\xee\xffylg\xc2\x03\xed\x06\x9d\xb3\xc1/\x90\xe62\xb2=M\xa3\xdd\xc3[\x83\xcfN\xbc\xa2Q\x92if2\x97If\xdf\f\xb8\xc4N#aQ\xbf\xd6\x16\xbc\xad1\xe0\xc4\x03\xd2\xcc\xed>\xd8*\x96&n\xa8\xdb\xcc\"_lOv\x1c\xe9\xf9=}a\xf3\u07f5\u06ddG\xce9\xaev\xad\xb8M\xf6\x8f\xea\x95\x04\u04ad\xe7\xa9\xc1\xd0\xd0)W\xe3+M\x96L#\u07c4\x8a\xec\x14\xd70\x01@\x8do\xf3O\r\n=n\u07b2=JK\x15\x867,i\xb39\x9b\x81\x98t\x1a\xe3\xf4/\x82\xc3\xf6\xa9x\x10\"\x05\x8d\x17=I\xa8\x1c\x99\x97>Q\x81\x04\xffJ\xfb*xp\x92*\xb2No\u5f6c\xde\xc7\x19\x8eD0\xd6-\xd5{\xc2p\x16\xb1\xdc\u00bb\x900I\xf8\xd7VE3=IGZ\xa1p}\x01\xc2\xef-\x99[\xd6\u00b0\x188f\xe6b6\x1f\xe8Bv\x16\xf3\x92\v\xf7\xfa\x8d\xce1\xfd\x96\x10\xe2\xa8\xea\xd5VZ\x8dB\v\r\n\xef\x8c;\xf8\xb2J\x8c;e\xe8\u00b2\xaf\x18\u03b8\xdd\xc2qV\x19\x05\xd5Q\u066c2pC\x91\x86\xfe0;\xea\x8a\xe8\xf2\x9e\xb6\xca\x01\xb0\x02ojoV^\xec\xfe0~=J\x90\xfcp\xa3]}\xabMU\x1d\x10\xec\x86)\xfd\x04\xf9eD\xeaw8\xef\xd4zE\xe7l\a\xf3\xa3#(\x13\xb5\xb5y\xd6\x14G\xc1O\xbc\xf7k\xf0\xe7\x11}\x15\x18\x9c\b\xc3+\xca4\xae\x18\xe7\xed\\\xe0\xde\x02\\\xa5\a\xd3\"K\u017a\r\n\x88\x9ez`F\xb9;\x8aqX\xa8 \\F\xbe^W\x95\xf1=I\u007f(\xfb\x84\u02adO\xd6\xe2\x9f\xf5\xfc\xd5y\x97\xf8\x1e\xba\xe1\x8f'\xde\xf7\xafm\x04`\x8d\xa8\x05\x9bl\xfeLz\xc07/\x19\xb7\xab\xea=I{\xa4\a\xdb*\xafy\xc5B\xfc\xd3\xf0\xd3g\u00f9\x11\xe3eu\xac(\xfe7\x8c\b\xc97%{\x90\x9c\xdc\u0719\xc3\x13=Jh\x99j=Im\xe3\x8b\u007f\xf5\x87\u036b\xf25\x81\u91d0d\x96C\xb6\xbf\r\ns\x17\xf1\xeb\xdf0=IWg\x15\xdf\x10\x8b\xa7\u007f\xa5\x02\x93\xde\u05ac\x99\x19d\xb4\xdfYt\xa6\xaf\x15=Jm\x13d\xf2\x8e\x02\xf9\xbdN\u0772\x19nm\x81B\x8e<\xf6@\xe3rv|\xabm\xe5\xdcs\xb6\xe2\xcb\xfd8\xa7\ud55d\x018\x04\u0615\xb1\x97\xb9$\xd2\x02\xc3`]\x1f\xdaqv\u007f\xd1\az\x01*y\x8fR\xe7\xb9\x177\u05b3\x13\xc3}\xf1sz\x04\x0e\x8e\u00b8\xfc;C\xda\x023U5x\xaf\xfbl\r\n\x1a\xdd\xe9\u007fUl_b\x88\\\xd0\ag\xaa\x04\xabt\xb3\x18d,\x94X\x86\x1c\xd4\xde\xeb\xb3\x17\xbe\xd6\xe5$=@\xcb@v\x9a)\b\xce\xe9p\xca\u039f!\xb5;\xb3\x97_fzT\u00ce\xb4\x9a\x87\xf0\x81\xe5\x97\u011br\x99\x98D\x9fV/\x9d\x8b\x01\x94\x8a\xd8W,\xd6\aFb?`Bvw,>\x0f[\x9a9r}q\u044bE{0d\xb9t\x04#\xbc\u5be8\xe5\xd1\xf8a\xe2\x13\xe8\x1d7b\x80\x99\xbc\r\n\xf3\xd8\x04w\xa7\xee>0\x97H\\\xc3\xde?0\xb7\xc0=I\xe3\xc3\u0278'3\xdba\xe1&@\x17H\xee\xdf9\u019e\x84=}\x9f\x94\x87\x9a=Iv\xb54\xfb0\xdb\ud61d\x89\x9a\xa2\xa3\ud51d\x0e\xfbd,\x83\xed\x9f'\x88\xe8L\x026`\xfep\xb3\xabt\x9b=}i\xf6?=}\xb0\x19\u0141\x97E\ub75e.I\xcc\x16\xd6\xec\xde\x06\x13\xed\x018n`\v#\xc6Y\xf6Zj\x92\x90\xf8\x16\x062\xa2b\xc6\r\n\xad\x89\xe0 \xa7%\xe3\xaf@\x01W=@\u04a7\xc3\u057e\xaf\xc1S\xf5GZ 8\xb5\xaa\xc4\x19\xb4ri\xc7\xf2o\xf2=M\xbb\x97n\xf8\xa6\x94OM\xec1\x12\xe0\xfb1=Ms<\x13\x9b=I\xd5V\xec<v\b\xd8y2'/\xe2W\xc4N\x96\x86\x0f^\xe6\u05bfh\xf6\xe1\xe1u\xb0\xa7\x9b\a8\xd0\x1c\x05\x05Py\x1c\x83n\x1c\xf3fDZe6\x82\x8a\xee\xb2!\xb0\xee\xc2\x19'\x17\x81\x1b!\xc9\aO\xaf\xa8\r\n\x8c\u07a3^\xeb\x1e\xe3L\x12,\x95\xbe\xf8\x16k\xe0+\xbaX\x89Ov\x18\x86\x98\xed\x9e@O\x06\xb7\x11\xe4eM'\xaf[E\xa5\xfdZ\xc4\xf10\x1b\xb64%\x95\x11\x90\u07fa]\xc1\xa3J\u06db=Im\xbe\x0e\xed\xf9cL6\x05\xec\xdfl\v\x83\xd0=M\xccoQJT\xb8\x9f`\xa2b\xba\xa0\xab\xd3aJ\u019b\xbf\x1a\xca\xc5sX=J\xc8o\xbbsd.\xc1<\xb2c\x1a\xb3\xe6\xc1k\xc9,\x87\a\x9f.\x80\x1e\r\n\xcc,\xa6\u007fU\xe6\xb6\x13\xfb\xaa\xae\xf4\xbb1h\xc9\xf8=J\x17G\xba$\x86\x8be\x97\x90.v9N\xfc\xd6\u0748)\xd8\u03e2\xd2d2B\xe3iv\xb9l\x15\xa4C\u0290\x19\xff\xdd\x0e#\x95@\u007f\xcb\x10\vP\u4633k;=I\xbe\xb7`\xbai\xaa\xd0l\xc7&\xac\x8a\x06\x92\xab*\u072126\xac\x15T\xe5\x82\x11F\x9a\xf1\xd5=IX\x9d8\u062cS\xe6\vg\xb1\x98\x11\x944\x8aV\xaa\x83\xd4(\x1f\xf9\xef\r\n]\xc3&5\x05\x10\xf0k\xa6we\x92*\u07f4\xfc.\x05z\xc4\x02\xe7\x80\xe3\xf5/Mob\x84j\x11 \x89\x88\u007f\xa7\x0e\x8f\xcc \xad]6\xf49\x03739\xf8W\u007f\xbd\xe7m\u008d\x19>\x8d\xc1\xa2\xaf}\xb2\xd0jd\x8f)\xbf~\xe3D\x89\x10\x94\x85A!\xe09n\u06a8\x15\x92\x96j0\xe2-\xf2^B\xf8\x03\x9a\x04\x19-=J\\\u044d\xd41\xcd\xe8\x82\u008dh3T\x81\x1b8\u05fd\x92\x9a^*Ea\r\n\x83\xb4\x0fsV\xfd\xc4\x17\v\xed\xa78\xd03\xe3J\u007f\x10&P9P\x12\x90\xacF\x0e\x19\x831\x8b\xe3\x8fuz\xb8\x96f\xc1\xa9eF;\xc7\x16e=J\xceJ\x1c*I\x99zz=MTM-\xa1y$\xfd\a\x87m\xfb=JX\xe5\x05_\u05e5\xe5\x03\xe7]3\x94\x1c`\xaa\x956\x17\x06\xfcw>;\x11\xc4\xc6\xee\x9e\xd8=I\xffd=Il\x05\xee 34?b\x1aw\x8c>\xcb\xdcZ\xea\xc0\x9c\xa7\xa0\xabF\r\n\xa1b\x1b\xeaC\x81u;2\xa3\f\x97\xa7\x90Zub\x9d\xfcI\x14#L\xc5Y\xf0\x8e\xc0\xf6\x90=MS\x89~x&\x98z\xccvg\xc43\x13\xcaf\xe4\xe4\xbcUA\x9f\x0f$\x88\x88\xfel\u03d2\xccv\xfb\xfa\xf2\x9b\f\xea\x83\xcc\u0466\xbc*N\xf6Fi\xac\x0e\xba!i\x04\x8e\xc8$\xca\xdd\xf6]\x97\xabhO>\xc7j\x8fk\xd7k%\x8f<\xef\x12Gz7v\u9607\xbaTV\xb5rn\xca\xdaVQ\xbe\xf5c\r\n\x1f\xbd\xb3\x8b\xee\x90i\xf3\x06\xfb\x8e\xec\xf0\xc32\x1do\x8e\xeb\xbd\xd8c\x9c\x15\x85\x06\x1e-9\xbf+\xb5\xfb\xba?\xdeJOw\x9c\xb5@nR\x94\x03R\x03\x16\xa7\xc72\xbf\xf3\xb3\\\xbd%\x12\x8e\x1b\xe9\x9c\xf2\xd5+;c\xb4\xdf\x11\xbc\xeeM\xaf\xc3\xfa\xb4\xa9\xa3\xaf\x0f$y\x15\x9b\xc4v\xd1\xef)\u0366\x1f6GoHh\xbc\x16\xf3!%\xf8\xfa\x19\x91mz\xf5\x1b=I+\x92\xcco/\xd7\x10\"+`\x8b\xea+\xbf\r\nu\xe7\xa4V\x87T\x04\x98Ce=J=}\xfc\vR3!\x06\xa8H\x91\xc8X\x04o\x87Xk=I:\xbe\xba\xf0\x8a\xef\x10G^\u023f\x1b\x9f\xa1\v!\xab\xbdxg>&\xec\xfe\xa1\xb1m\xc9w\x9d\x01x\xf1#\xc6n\xeb^\xe0\xd1U\x85/\x85\x1b=M\x86\xe87q}u\x1b`\xee\xda\xef`\x99\u035f\xbb\xd1rs*\x14E\xbdC\xfb\xf1 \x92h\x8d\xad\xee\u1719[E\x8e\u0772\xb9\x80\x99\xadU7PxD\r\n\x8a~l\u03df\f\b\u0798\xa9\x01\xb1\xd8\u063f$r\xef\x1f\xcb\xd0z=@\u0358e\xfc|_\x8e\x97\xc9\x05K7\x84!+\xbcf\xac,\x96=}\x13W\u02fcf>\xd3\xcf-\xafI\xba\xa7\x0fz6\x9e\u0302\xa5d\xe9\xa8.\xb9\x1a \x8e\xc1\xf4*}\x1e\xc3\f\xa2\xd1{\x9b\xe6\xd7\x02x\xf0\x92\xb7\xfc7\x9d@\x11-\xab\xfe\x03\xb4\x82v\xd1L\x83\xc5p\xa1;w\xcfG\x13\xb3\x0f\x12\xcd\x06\xc7D$\x02\x13\xbd\x03\x96\r\nr\x11\xecx\f\x92\xdaXM*-n\x85\u01da\xd3\x05 ,9';\x92\u0695\x05\xc8E\xe0K\u632a\xca\x0e\x82~A\xe7#\xd4iL\x03\xbeKuv\xdc30R~\xeb5?\xb0\xa5\xdc\xc7'\x97;\x9f\xec?\x16\u007f\xdboX\x82\xf3f\xa8R\x85\x86h%y\x80\xf1+\x01\xef\xdcD3=J2\xbfw\xcbK\x1c\xb1\x97\xb4\x83\xfc\x90\x99\xf1p\xba\u022e\u073a\u0694\x8e\xaf\xa0\xa1:e\xcf\xcb\xc08\x0f\xca0C\xad\r\nz\xf3=}Xw\u368ff\xfa=@\xe6\xee\xc1a\x84\xeb\x02\xbe\xb7|\xc7=@\b\xf0\xf6\x1fmT\xc5b\x98\xd7E\x0e\xefz\xee\x8b$\xc9\u06d6\x84\xa1qR++Z\x1eHD\xaaSlO\x90\fx\x1c\f \xfbS\xdd\xf5\x90\xf5\x8c{\xb7n$\x85[v\x9e\xf5K(b\xedOF\xf8\xd0\x1b\ua1c2\xbd\u00fb\x05m\xd1\f\x99OK\xa5E\xeb1^z\xac\xc8\x05\u03b9y\xd2^\xf0\xe2\xd9\xda\xde\xe9\x93s\xdc\x17\r\n0\xfd\xac`O\xab\x1a4\xd7\xdfW\xb2\x9b'+\x0fP\x94\xcd\xcc\xfa\xce^\x91\xd2n1l\xb9\x1c\x9a\x98\xc9\xf8\x19\xe9\u033c\xec\xe1\x8bo\xcbak\xcb\xc9\xf4\x95\x81\x1e)\xa4x\x99\xc3\xd2\xdc`J\x1eCT\x1fl\u0672\x06\xf4W\xbfm =Iq\xe5\x8e\xf7\b\xa0\xe4`\u20baM\xd2\xe9\u0580\x84M\x85\xb3k<E\u0285=}\xea\xf4z\xf3@~b\x0e\xdc\xfe\x12=J\x16\xe76l4=M\xa6\x99\xf8,$I\r\n\xfa0\xca\xd6=@\xc6\xe1\x1f\xbc4\xba\x91\u043e0\x8fj\xa8\xe8=}\xf4\u007f\u065a\u04fcQ\xe7uE\xb5\xec\x11`\xcc\xd5CTy\xac\xfe\x8d\xa3\xc5\x19\xd4\xd3\xe5Y\xdd7>u\xaa\xab\xd4}\x1d\xf3A\xd3=IW\x04\xad\xe1\x89I\xbdTue}\xc7\xc4\xc5\u00d77\x12V\x96E\x80\x18\u020e\xbd\x9c-\xe1\x8c\x0ez\u04a6\u02ba\xe8i \x8a\xd2\xee6Z\xda\x06\xe9\x87\x0f\x8eH8\xb3:=@K\x1e9\xb4@!z\r\n\xa9\x88L;\xecTK,\xee/\x85\x8b.\xe5\x94\xf5rs\xbd\x90~@\u036c\xa3\u05fbZ\u036c\xb9W\xbb\u06bf=IS\xbd\xe2\aK=@'8\xb4S\u007f\x1d\xea&\u007f1O\xa7\xad\xc0\x90\x91\x12\xa6\xa3\x05\xc8\x15\u007fX\x1c:\xe4+\x1f\xf4\xf2\xa5H\xbeGZ\x84\x8f\x1d\x85\xff\xb26,,<b\xa67\u0588O\x99p\xc3\xe6\xff\x86,e\xba\xdd\u0086\x10,\xf2\x88]\x14\xa6(-\xee3I\xf0\x19\xd9#A.?\xbe\r\n\xa5\xd8PR\x8f\x1cp\u41b0\xf4\"J\x19O\xa7v\x9d:\"\xcb\xe6x\u3540\x13\x9f\xd8\xc2\x1cd\xba\x1d\xc0\"Qr\v\x8eE\x8a\x9c\u01d8\x99\xb5A-=J\x95\xaeAET\u07ed\x18\xa1\xa3\x11\x98\x87\xa8\x06\x05\xb1\x8a\u03c0\\\xd8\xcf9\x83\xea\xb1J=@\xe5\vm<c\xfa\f\xd2p\x87\xa7\xb1\xdcN\x82!e>f\xc5\xf1ZT\x97n\xbb\x12^7,\x17\x8d\xae\x151t\xe1\xa50\xab\xfa\xfc\xad\x82\x01!\x92\r\n\xc4\xfa\xfb\x95*\x02\x85\xe1\x15\xacjj\xd6\\F\x95\xeaL`\u007f(\xb0n\xa4\u9696a\u0251\xbfV\xc4\xdf\xf95\xa6\xcd=J\xb3:\x12\xdb=@\x1bn\xa0S\a\u007fG\xff\xe7_k\x8cJ\x1b\x99\u0303\x8a\u053e3\x8c`\x9c\x1fH1\xba\xe7\x01\x86\xfa\x02\xbd\xf3\x9eX5V\x97\x17\xed\x1d\xb5\xf5M\x17\f\x1c&#\xf5QA\x04\xfb\xfd\xcd\x11\u0645Yd\x92S\xa2\x1d\u0675k=Jj\xf8W\xe7\x18\xees\b\xf3\xbd\r\n\xc8\u01b0,7\x0fx@kq&%\xf8,^\xd8K\x10\xbb\xe3\x99\xcbn0\xaeJ\xf4\xfb~=J7\xb5PR\x84>\xdc%\x1d\x15\u0722\xadSR@\xa4\xa2\xb5\x1bB\xbe\xad\xba|\xe5\xe8\x84!\xf7\xef\xf5\xcb~3ak\x04\xbb\xc867\xdf \x80\xd8v\x15\xa8h\xa5\xaf\xe94|\u01a8:\xe6\xceGr\\\xd8\xc4\xe8\xf3\xb7\u04192\x8c\xf7@>\x0e\xf2\xc6x\xf1s\xe9i\xc7\xe4^\x1a\u02a5`\xb6\x86\x94\xe3\xd3?\xf1\r\n#4-\xfc\xe4\xf3\xfd\xa6\xae\xb9n\x1f-\xfa\x90e|\u0766}QcH\xa4\xa8W\x89Q\x16\xadh\xed1Q\xbahR\x89\xa2%\a5\x17\xef\xcd}\x1d\x02\\xI\xc5Q\xf0\x0f\x1b{\xc6N\x9b=J\xcb!J\xed\xa8\x1fV6D\xbbd\xce6i3\xab=@$r\xa9\xb0q}\xc7?I+\xbc\xfd\xf5q=I\x16\x90\x01\xe4\x14\xfbyZ\x93\x0f\xc2\xc9fc\xe7)\xfb\x16$gH\xf7\x8b\x1e\x1c}\xcd\a$\xd89\xa7\r\nvx\xb9Rc\xdbx\xd7%IF\xc8\xcd3\xd8\xedW{\xa6\x90\xd2\u007fY\xc9\xe6\x1b]I=}\xf5\xa2,\x06:\x1f\a%IG\xa6G=M\xfb\xd0Ke%S\xce\x16a\x19qi\x10]5gW\x92\xa4T\xd6\xdc\xcegW\xd7<\x8e\u070b\xb6\xd1\x02\xafh*\xd7'\x9e\x12J\xfc\xf1mG\xf0k\xb5\xd1Ba\x96\x85\x15\xa8\xa6\xa7\xaf\xd1:\xc5\xf29\x8d\x1d$9\x8d\xa2}}\xd1k~\xf4\x89\afL\x84!\x1e&E\r\nHmSW\xfe4\u0396p\a\xc1\\\xcc\x1bj\xfeh\x11\x0e\u0356#\u032eGF\x8d\xaf\x80\x95\x9d\x05.\x9b\x8a\x93\xc4\x12vI\xb7a\u0182\xc6\xcf{\xfb\x028\x85\xc0uV\x80\xf9\xe3\xe3\xa1Rlr{\xac0r\u0643;\xb2\xd5\x0eJT[1\xb4N\xa7\x03\u074e\xa67[z\x0e\xeb\xbbF\x1b\xad\x157!\x05-\xbf\xbb;F\xaaav\xf5Z\x14\xf6\xbb\x8b\xbe>\x80\xf1\xa2\xbe\u076b\x12\xea\x9ez\xb7\x87p|&\xf8\r\nt\xdc?\x840\xd2\xc0\xa8E\a\xa0\xbc\xbdT\xff\xf2:\xcb\xe07\x9e$5Q\x0eL\xaaD\x03\xff\xb4\x9b\x19\x98\xd2\xe0;\xe6]\x84R!\xf97\xf6\x0f\xec\xd3\xf4\x19\xc0\xc1\xea\b\xeb,\xeb\xee\xce?y\x8b\x83H-\xebu\x91\xee\xaa\x12\x9d\xa7\xe3\xec\xe1\x9e\xed9V\xfc\xa0V\xb0\xfcN\x98\xb8\xe0\xed\x9f\xcdw\x86=}/\x8e\xcb%\xec\f\xe7\xba\x15\x1fw\x92\xc2x0\x1ba#\u0232\x1b\xcb\x11rw\xf5\"\xd1a\xb3\x05E\r\n\x9e\x8aK\xfe\x1a\x0f,\xccd\xdd\x03\xe5\xdd5>\x81\x89\xd71=}\x0f\xe9\x99:\xff\xfb\xe4\x1d\x01*\x8f\b\xcf\xf9\xf8\xf6\xf3\xbe\u01c1\x88\xc9R;\xc8w\xca\xf6\xf9Ur\xf8\xd6%\xa3/oz\x1d\xbf\f\\|W$\x18U\xf2yG\xf2c\xe1yBe\xef6>\x8c[p\x9f\xec\xd4\u036e\xc6R\xef\xca\xe4\xea\xc3JX\xc2\xd2\xd1p\xd3/\xf8\xf4\xc2cd\xdb\x1c\xae\xa5x\xb2\u02cd\xe4G\x05N\xb0@\x18:]\x1cT\xfb\r\n5\xe5V\x19\xd1\x1c\x8c\xcb_\xbb-\x10\x1b\xcbl9\xa0\x17\bnJ\xdd\xccb\x83\x1b\x0e\xd4&\xb9\xe3\x99\xfc\xbd\x19\xd9c#\x12\x9b\x04\xe3\xc2n#*g\u007f\xedk\xb6\xcbw\xf3b\x8d!3\x10\xcc27\xd4\xefT%%$@W\xaa\xe8\xff\u0243\xf8\xf1\x99\xffP\f\xb7\x14\xf7\xa5R|\\\xed\x1ff\xce\xfc\x17\xfa\xcd\x12\xdf\\F\xd1\x17\x8a=Jwe\x1a\xae3gt\x8c\xa5\xb6\b\xd7r\xd3S\xbbv\xe7\xe2\xc0\x99\xb5\x8e\r\nh\xb2\x10O\u06fa\xcf_J\x86\xc4\u015b\xedt\xb1(4a~=J3\"\xf4\x9c!)t=J\xb3>|P\x0f\xd7)`z=J3\xb47\\\x0f\u06af\xe3O\xa3\x96\x03`\xdfG\xa2L\xff\x184\xa3^\x06\x97t\xb1\x81\xf4|k\x02\xd2\xcc\xed\x95J\u0114\xbenU\x9e:\xce\x04\x87\xfc\xea'M*\xba?\x81\x17h\xbf2\xaam\xc7*\x8c-\xcc8X\x12(\xb6*\xa8P\xcb\xd5& \x91<\x84\x10\x83\x1f\x83=I\r\nI\xba\xaeL\xbbm\xf7\xe8\xf3\xfae\u075c1~\x8c*/#\xc4O\xae\xe8W\\\xdf\xe9ulE\x16\xd0\xce.\xc2\xe2\xef\xe3\x87\xf9\x9d\xa32\xc1\x1e]\xfb\xd2\xf4U\xd8\u072b\u0242\x81\xc3:\xbe\x8d\x81\xa8\x8c\x87\x85\x8a\x87zN\xfb;5_\x8a\x95\xc4E\xf1k\u05aa\x1c\xdc,\u007f\x9a\xb5t\x1b0S\u049f\xac\x10\x05\xe3>8\xf5^\x1e\x9b\xf2=I\xce+\x10p\x95\u063d?}\xf2I:\xa2L*\xfb\xe3,\xc8L?\xac\r\n\x84\xa4;\xac\x8cT*J\xea\xd7DB\x96\xbd\xe3\xc8?\u0117\xf4\xe7\xda7\f\xaf\xfb0\u007f\xf1\x93\xc5\xe4q\xc2=}Z\x181 \u01c7\xfa\x84!\xb6\xf2\xfb=I\xf6Z2YD\xfc2\x10\x9a\xb2\x04\xb0^\x8a\x8c*\xd3\u0116\xb5T\x83\x8b\xfc\xb76{)V\x040dBj5\u007f\u07b7y\xed\x0f0\xbe7Nn\xf8\xca\x03\x98-\xa0G3N\xa2c\x92\aj\xafm8\x84Ar:\xb5 \xe9w\xbd=@\xbb5\u0299\r\n\xb5`\xf2=@\u05b7\x1dK\xd4\xe2\x15T\x85\x85\x18\xf5\xdc(\xe0\x9f\x93 \x03\x86 #\u02af\xa7H\xb0\xad\f\xf9\xe7\xf3\x9f\x059\xfa\xeb\u0743\x1e.\xd0Y\xa5\x96\x8f\x8c\x88\xfe\xb9~Lo\xb2\x11.8\x1f\xcb1\x12\x03o\x01|\x82\x0eq\u01c5\x87u\xe5v\x02\xee4\xcc\xfd\xe4s~\x8d\xd3\xf4D\xee\xfb\xb4\xbe\u0528\xbe\x19\u0d8e\xfa\xe9\x0e~N\xa6%\xac\x9b\x92\x8d\xac\xbf\xfe\x94\u0320\x168\xdd\xc3g\xf3\xf3N\xc6\r\nYr\x0e9<\xb9\x0e\u00c7\x8fNY\x9d\xf8\x97E\x92\xa0=J\xf1\x19v\x1e\xf0g\xb0\u030f-0\xc1\xcf2\xb7:}\xbbu\xa5\xc9\xe8K\x96#\x8fV?&*,\xbfZ\x19\x10\x04N\xef,\xf5\x98\xee\xec\xe0x\x0e=J\x1e9\x01\b\x0f\x1d+%\x80\xff6\xf2ay\xa2\x03{\x1c\xba\xaa\x16\x05\x94j\x9f\xdf\x14>9a\x89\x884=J\xf1\xde\xc8iN\xe7\xf1t\u073f\xd8\xf8d\xe7\xb1=J[\xb1\xb2F)\xdb\xdb\x1e\r\n\xf9\xac\x18T\xa8(\x83\xfa\a,w)\x83\xfa\xd7U\x12\xcc\xd9,g\xcfw\xe4\xf2\u05f7\x1a\xe8\xd3B\xe2\x803\x19)\x1a\xfeHG!\x15`\x1d\xdfel\xef.\u0532\x80\a\xd6$=@\xad\xaa\xb7I\xf9\xcaj$\xdb\xc9\u7963\xc7f)$\xa0\x87l\x16\xad\xc0$\a\x161\xf33\xdf\xfa\xa0SN\xff\x87\xf8\x9c*\x13\xcfp\x0e\x18\x91O\x1d\xe5\xdb6i\xf3^\xe12j\xa9d\xc3pG`\xfai\u02d8+\x8a=I\xfc\r\n\x15xE\x94~\xc9\xf4\xbcM\xbd\xca\u042ft=@PT5\xe6;\x12Gw\xfe\xf5\u05b7\x9fR}\u007fFW\xff\x9a&\xcdS:zKR0\xe5=I\x16G<\xb6ZE\x9dK\x1e$\xd0\u00f1jE\xe7C\x9e\xd9\xf8\x89\xa8f\u01f3\xef\x0f=J\x1b\x04Q\x0f\x13p\x84-\xa6\xbc\x19\x85\x88\u02f1/L\x88\xe7\x86\x14\xbc\xe52\xa8\xb9K.\xa2=@\xef\xb0\xc1\xf3\x02\xd7\v\xbce\xba%\x9e:\xca\xceK;J>\xa2P\r\n0\xe7=@]`t\xad\xed%\x85\xec\x8e&\xd80o\x9a\u06a8\xe4\x10\xf7v\xedi\xe1\xee\xfe\xf1\xfaHz\xb0\xb3\xf9\xe7\xec\x9c\xf6\x85\xfec\x0f}R\xf6\xeb@\xfaj\u0517\x99=@\xe4Y.\xa4f,\x05\u0172\xc1\x17\xb9\x81\xed\xdf\xcbYU!a\xd1\x0fV\xf7\xc1Z\xc6kE\xc6\xece\xc2\xf5\xbc\u03cb\xa25x\x94b\x9f\x1a{j\xd4\xc4\xfe\x8e\u05f4\x1c\x1e\xe4V\x8c\xd4tW\x82\xfd\\\xbf\x05lc\xef3\xed\x15\a\r\n\x9f\xb4\xba\xebk\xed(%\xf0\x89\xab`\xa62\xe8]\x84\x11\xf2\x85\xe5\xb3\xcd.8\x84\x88m\xfd\x995\xf9\xcbz\xc5\x1a\xcbp\xb2|\xa4e\x9a\x1a@L\xdeF\x18#\xbf\x13\xec\xfd\x19#\x99\xbf\x87\xbd\xed\x82\xea}\xd2)\x9a\xef\u0637\xd6(k\x8c\x1e\x95o\x9a\u05cb,\xdf6<XZ;\xc4\x15\xb5+\xd0b\xe2s\xc2#`\xc5O\xcdcy\xf0\x95K\xcc\f\xa3\x83\xbaF6\xfd\xd2\x10\xa3\x12\xe2\xbc\x1f\xdb06\xa1u\x1bK\r\nJ=IJ\xe1&\xf6ClH\xf2\u01e9%`\xb4\xf0=M'S%v\u0313uZsGT\x1a\xa3Z\xbd\xb2]\x8c\xfc\x84\xf4hl~\x9e\xe1\xbdz\x1f\x84D\xa1\x12Z}5j\xa6\xcd&k?+\xcbn\xe7\x13\xd5iB\xc5=}\xbd\xb3\xc0\x96\xf8QyuvA,sO\xbf\x86\xb8\"\x068\xfd\xafqr\xe3bZ\f\xde\xf8@Bp}\xdfJ\xa4\xdea\x89\xf4|\x92U\xae;\xb5=M\xe9=@\xba\b\xd86\xdb\r\n\xd9]=J\xea\xf0\xe8A\xc2\xea?y\x97=}m\xca\x15S\u0169\xa2\u02eb\x9c\xee\x9aw\x90|\x05\xd4\xcb\xeeX+\x17\x84\v\xc8\xe7U\xf7F\xd4\xfdEFU0\x97\xad\x1e\x95\xba\xa4\x9e\x961CI^\xafj\xde\xces\x91\xb2\xd7\x1a\x95\xe5\xee\u0661\x19\b\x06\xa9\xa7J\xa4\xe2l\xe3\x01\xb83\x9d\x125\"\xdah`X\x10J$\xe8\u04dc\x11$c\xb2\xba\x06\xfa{I\x93\xea\x85\xde?.\xb7\xe0v\xa38)\x02\xffS\x10\r\nB\x06\xf4FZ\x02>\u05c0*6\x94\xd3}\x0f\x14\x82<\x83\xba\xbc\x91\xdf\a^\x82AE\x88\xe5\xeb~\xa1\x85\xeb\xf7z\xf0\xbf\xb5\x1d/7TM\xf7\xb3\xa7\xb639\x9c1Wo\x0fI(\xbf\x93\x83=J\xcf\xc2\xd8\x1e\x9a\u07a6\xe8\xd0\x0f!\xf9\xbeM\xf1&$\xccQ2\x0f\xfbWC\xa4=}SB\x16\xa9\x8aY\x05\xe9\xe9&\xa0\x88+\x97\xbc\x91\x8f\xac\u03a2\x06\u00fd\xb7J\xe46\xba\x1b\xa6$\x86`Jk9\xefh\r\nJ\x89\xae\x96\xcfVe`\x80Ngz^3\xdce\xedN\u01e1\x19\xe8\u0229r\u0226x=M\x8f=M=J\xa6>\xcc7\xd9V \xe9#\x11n`.\x83\x92\x8bd\xfaAG\xda%3DSt\xe8\u0243\xb6\xa9\xf1\x9a\xd7\xe1\xc9\x0f\x95J\x05\x96Iv\x885v\xb4\xe1\xfa\xb4|\xe60\xdd[M\xf1\xf1q\x84\xef\xf3R\xb2\xadI\xa6\x91\x18\xf7z\x80^\x9e\xe7\x1d\x1c\xc3\xef\xd8\x11\v\xef\x0f%w\xc96@N,\xbd\xd5\r\n\xd3C\xa1\x1f\x8d\x83\xb5\xc3\xd6\xffM+U\x01\u020c\xba\x12\x80\xc1\x92;z\xcfm\xa8ZrP\xe2nk\x8b\xb0p\x8c\x11 \x9eV\x8e\xa1\xba\x9d\x96J6\x96U.<t\x12\xef_\xb4\xc8\xcd|\xa7:\x1b\u07a0\xbcN7\xecT\x82\x8a[\xa9\x12 #n\xd8l\x18\xf2Q\xfa\xf2Il\x12\xbc=}`K\xa6\x04I\x10c\xb9\xb7C0p\xcb\xe5s\xae\xe0X\x12\x02\xd4\x1a\xdds\x8a\x04`5S\x10\x19\xa22\xc5-\x06D\xd8\r\n3\x9a/\x87:`\x1f\xbbf+\x06\x16\uf877=M\x83@3x\u04a8\x9ev\xb9\xc8\xc6Fo=M.o\xd9\xf6X\x95\x8e=M\x95Y\xf3\xe6\u020b\xcd\xd78=@R\xfa\xf9m\x8b\u007fOy)\x0e[\xecn\xc7m\b\x8d\xd5\xe9~\xc4L*J\xc5;B\x17G6\xf9\x0fEf`I\x1d\xcd=}}\x02\x10\x80\x99=J#\x12&\x1d\x87\x04\x90h\xbb\xfdT:\xe7\xe4\xc6\u02f5\xf0O^\xfe{H\u0438\x16\xcc;o\r\n\x8d\xf2\x1f\x96\xdcS\xb5\xaa\x9f=}6\xa4\xff\xff\x17+\x03\x19\x84\x0e\u007fGWG\x89\xf0\f\x12e\xbb\xad\u0535\x15\x18&\x16\xadv\u07e4\"\x83tA@w(N\xbe\x11\xca*\x9e\xcb\x19\xda\xef\x8dO7@=M\xc0\x14/\xf9\xce]Sk;:)k\xe5\xaar\u025fIR\xac\xe9\u02dd\xc5\x03\xa1\xa5,^V\xc0\x8a5\xb0_:\xb6~?\xa1\xde\xf1\xd6f6P\x91\xad/\xa2\xb1\x9fu\xa8\xe4\xa4Xlp\x872\xa6\xeb\r\n\xa5iY\x88\xadk\xd8\xcag\x01\xdd\x19\xb4\x0f_\x86gR\xa7<f\x90\x979XPrm[\xe2\xf3\xbf\xd9Jl\x86\xb8\xfc\x91&\x1a\u052f\xfaU\x1c\x8d\x94\u04fb\xc93GwE\x12?\xd5\xf9\xe9I,L&\x03O\x9f\x95\x0e\x1d\xf9\x04\xec\x89\xd7\xe0\x9a\x89\xc1\xfe\x89\xd2\u0635\x92D\xbbp=}`\xbb\xe7C6~\x1a2\u0394\xcfu\xe4\xb76gBfL\xbf\xd1n\xf3c\xa6\xa7X\u0354^\xe5\xec]A\xf7\u063c\xf9\r\n\xeb\x80\xd6\xed\xe5\u0147\x19/rt\xa9\xe8O\xe2\xaee\u0703:\u049a_\xe5\xee\xff\xa0\xbbb\xf1*\u007f\x17o:\x91\xc2\xd1\x04\xe7B\xf6\x1d!}&\x10\xb8\xb3\x0f\xc2\xffOZ\aj\xe0\xe6J\xf6\x98\x9f\xd1\u0089\xaa\x83}\x1d\xfa\x1fqL\xfd\x9d\xf1\xde\x1dC\xc3\xfc\xe2\xf9Q\x10Z \x92\xbf\xe5\xdf\xc5=@\xe3=@g$T%\xafV\xeb8\fc\xb2\xac\xd0\xd3\\-t~\x90Z\xcaP\bg!$\xbbu\xb6\xea3\r\nM\xc1\xf7\xef^\xc9\xe4z\xf9\xe0\xc3M\f\xd4F\x1a\xc5/4\xff\xebQ\x1c\x02\xe5\xa2e\u0554\xc9\xcet\x92\x16p\x8bq\x14\xa3j\x89\xd4s\x92\xe8\xbb\xeb~\x0fMX\u00f1\x10\x9c)\xbe\b\x11j8\xb9\x01HG\x8d\x8e\x8b\xbah\xfchb=MJ\xb7\u0320e\xb1\x0e\x9c8\xf2^y4\x90\x98:%\xd7\xd0%1k\x98\xd6=J\x9e\x94\xe4{\xee\x83J\x82\xee\x10\xae\xb7,\f\xb7\xf6\xbax\xb6\xe3\xe8\xb4\xff\x85\aG!\r\n\x99\xd1\xdb\xc1:j\x99h\xc2=}\x03C \xd6+T\x93\x05\xc5\xfe\x1b\xd77\u010a\x90\x13\xdb:\xb4@H5\x9c+bl)\xd5\xc7O\xaaY}2=JAe~:$\xdc1n/.^\xeb\xbe\x10%\x0e\x9c\x13\xe9\xe2\x88\"Y\xb2Hz\x8d\xaf5\x06\x17\xe9\x1c\xef3\xf2F\xa8\xa5\x1b \x8e\x0eHW=@\x1f\xce\xef\xcc\xee/\xccB\xf4Q\xad\x06\x9f50\xd5\x1c\x90\xd0sO\x10\xcc-7)x\xe3\xdaoZ\x1f\xcb\r\n/\x94\x1e\xe8\xb2Z\x80\x1c\xe9)\x15)-\r\n=yend crc32=dd91fffd\r\n.\r\n"
+var xzverClient = "GROUP alt.battlestar-galactica\r\nCAPABILITIES\r\nXZVER 160000-160100\r\n"
+var xzverServer = "211 26503 152413 178915 alt.battlestar-galactica\r\n500 What?\r\n224 compressed data follows\r\n=ybegin line=128 size=-1\r\n\x17\xa7\x93\x9d\x05r\xdc\x17\x91\b\xb2%IZ8wv!\xe6u0\x94\xed\xcc=J\x17\xcbN\x85\x88\x0fo\xbc\x01YG\x82s\x9a+r*\xceV)$\xa14R\xbc\xbavrNK!\x9c\xedGGW\xe1\xac0\xf3\xd4\xd6\xd6\xf6\xbdQy<m\xf1i\xd7\x1d=Jze\xa7\x1dsewM=I\xe3=@\x04\x97\x17\x02\x1b\xdf\x01\xeb\u007f\xd0\xc7e\xa3>!\xddx\xd1\xcdc\xdb\u06478\xac\x82(Q7(\xbf\x93\xf1A\r\ny\x85\xb9\xf8Fyex-\x99\x1er\x15xFYI.\xb5NGQ\x9b\x19\x04CNs\xf1uP\xe9\xdfX\xc6&\xa9^\xec\xdf\xfd\xea\xff\xd2\xd8\x05D;\xb1\xfeb\x0ev\x95\x15,\x19=I7\u042bI\xa5I\xed\x99\xf0\x16\x8d\xa8\xbe\xe8\xb9\xe9zi\xa8)\v\xf5\x19'\xe3Y)\x06\xf5\x10\xfdJ\xb7\x1b\xab\xf41\xa0\xb7o\f\xf0=@\x13\x82\xc9\xf9\xb6!\xcea\xb3&x>%\xfbL\x1c\x11\xf8\xe2=}1#\x99\xc4\r\n\xa6\xb7\x19\x1b\xfd\x92\x98x\xcc\xe4\x01\xb6\\\xe6\xeb\xd1\v\x16\x9c \xb86=I\u007f\b\xa2\"\u0426=M\xa8\xfe\xa1\x80\x19\u055f\xe3\x97\u016e<'jiJ0[\xee\xab\x19\x14\x8b:$\xa0\u0662\xfb\xe1J\xf01\x16\xf6q\xa2\xa5'W\xe8}\"\x17\x13\xb5V\x94\xf9\u0579\xb8\x1e\x81q\"\x11=@\xa1T\xc9wwqg\x05\xce\xdf\xf9\x98\x9b\x8cDW}\x99\xa7t\xad\x1az\xbd\x19\x06\xa0\u0368\xf1\xf3\x1c\xfe\xe34\x86\xe3\r\np\xc4]\xf8e\u063d\x11=MJ\xf5\xc7\xde\a\xa1\xf0\x02\xc6\xf2\x9d8s\xdb\x11D\xe0\xd6=M\u03a3=@\xf3\xecx\xe8b\x8e\xb6\x9dt=@\xe9\xef\u0672XR\xe1\x1f)(\xd9\f\x99\xba\xectH\x8b\x19\xd9_I\xf9v\xbd\x15\xea\x01\xda\a\x04\x8a\x18\x94\x18f\xc1P<\xf8}&P\xef\u067d\xa3\xa9\xaa\xb1\xa1d\xd7q\xf9\u01c2e\xb0\x9f\xeesY\xc5d\xbd\xa6=J\xee\xcdx\xe0\xebT\u04a8\xf2R\b@\x80K\r\n\xd7\u0702\x96\x14ZA\xb5w/M1\xe8\xad\xdf8\xe8\x89\x15\f\xbf\xb0\x11\xfb\xda\x06@\x8b=@X\x87\xdb\xf4\xb0\xf9\xed\x0f8\x14!\xe7\xfe\xdc\x0fu\xd3\xcap\x17%\x95\a\xf5\xef\xf3\xa2\x06\xdb7\xb5\x041\u0273\xfb[/\u190b\u029d\xc2L\x96`\x9a\xdc\x15\xb4;\xc7B\x01\xa8\x16\xc5\xf9\b\xa6C\xc7X\x98\x82%\xf1\xfc\x12\xd0s\xc01\xa81\xc5\x13\f\xfa\x87o\xa8h\"\xd7\xef7}\xdev\xdd\xeb\xd0=}\u07ff\r\n\xde\x02\xf4\xa3\x16\xee\x03?x\xdc\xd9\xf7\x10\x02\xfaR\xb3]\xd7\xd7'\xbcO\x84\x12\xceb\x17\x01\x81\xf8\x1f\xd9\xd7\u074a\xb6\xadG\xb6\a\x8af\xa0\xa4\x9dYTx\x01!q\x84\x98\xc8b\x0e\x86%\xa9\x9c\x1d\u0789\b\x89\xc8&\x04\x14\xfcK\x85\x1e\x93Q\x1ed\xee\x16\xba\x88>\x11ki\x9f\x1c\x8aF\xeap\xff\x83X\xf8\xbe\x930\x82\x90p3gZ\x9e]\x1a\xe3\x15\x1f\xe6o#\x88\xaeC\x80\xb5`[\xa1\xd4\xe8cU\xe8#\r\n36|DX@C=IS\xff$k\\3\x1c\x1e\x84ul&h\xf4^a\xfaP\xb8I\x92\xa3\u0307O\x13\x12i\xe5\x03Z\u0471d\xe7\x8b\xed\xc8O\x97B8=M\xd8m\a\xb7\xbc\xc1\x02(\xc1=I\x88\x90\xeb\x95'\x92\u06e0\xac-\x11\x8d\v.a\u00ef\x9cE\xec\xda5UO\xa1\x83\x1319H\x13'\x0eR]\xf9\xf1\x1d\xa8\xef\x03W\xf6\xd2 7\x84\xf6`\x17\xc0kD\x02G}\xd5\xf9\xb5\xff\xe9\x9a\x10\x8d\r\n\x17\\\x149\xde\x1d\x8a<v\x06JW\x06\xae\xc5y\xa4\xa3Z\u00f8\xadF\xb0\x82\xe2\u0689\xb8}\x86m\xc1\xf5\x89($\xe7\xfb;\xa8\xb2\xff\xb0\xfb\u07df\xa2\u07c0!\x8e\xab\xa5=M\x9c\xa4\u03e9c'\xbc\u007f\xa7\x18,=I\x86\bM\xc5\xc0\u069eIb\xd7('u\x82\x02\xd4Mn\xe3\xc7p\xe7\xb9=@\xe4\x0f\x94\xe1\xdb\x04\x19\xa8\u0241y#\x87\xd5\xd7\xe0\xfd\xdcg\x1f8\xd6=@i\xdfbaD\x986\x81EM\r\n\x84I1x\xd8\xeb\x1c\xb9&s\xbed\x8d&\x9eP8\xd8\"\xfa\xbb(F\xa9\xb2\xc7s\x1a\xc9=@\xaf\xc1\x0e\xe3 \\\x1b0\x84AA\xab\xc9p\b\xcaa\x9a\v>\x94\x18\xa3\x169i\xee\xe7\xcc \x91#\xa1\x04\xb9y\xb6\xbd\xbd\xfd8\v\xc6=}p\xe2x\x8f\x82z\b\xcb\x15\xf1\u07da-\xbb\x80=}\xf5=}\x145D%\x88\x88\xa5\x85O,F\x13-T\x80#+\x80#\x16!\x16\xb0O\x06\x95\xbf\x85\x88\x94\xa9\xea\r\n'\xcdV\x9d\xc5\x15\xc2D0\x99\xdbP(\x04z%\x90@% \x02\xc3\xee)\"\xf1i\xde\u07557A\x80\xba\xc1)o\x96\xfb\x93\v Db\x81M.A\xeb\u007f\xc2\xf6\x8diu\u031b\x99\xba\x0e=}QD\x01COf\xb0\xa7rW\x96\xb9O\xc7pi`\xc7\xeeers\xa0j\xa4x\xbe.\xc7N\x17)=@\x14\xc8\xe7\xa7\x04\u02c6\x01\xe3;4\xf5\xfeG\u02c5\xec\xe0FU\xdatmw\xd0\x1a~=MR8~\r\n\xe6Sw\x82\xd1Z\x90\u01da\xb0=J\xfa\xfc\xf7 \x8dQ6\xd9\xf9\xf9hf\u0225\xcdH\xa8x\x0e\xe7\xb1=J\x1at\x86J\x18\xde=I\xe9\xa9\x1f\f\xfd{\xff\x01\u044b\x13\xb4\x99\xef\u01f5\v;,6f\xbd\x1b\xad\x15\xbe\x9f2\u0757=@\xb5\xf1\xfdra\xd6x\x16$\x88Q\x9d\xe7\f5\x95\x1c5\x83Sg\x1a\xeeF\x89\x92\x14\xb7]\u037d\x0f\xa8\xe3D\xbe\x03\xcc\x03\b\u0094\x99\xd6g\x19\xfc\xa7\xb9\xbe\xfa\xf5\xae\r\n\xcbb|\u00f3\x1f%=J\xc6\x1d\xf9\xe6\u01c7\u007f\xd8\xdc\v\xc7q\x14\u0663\xef\xd4\xcd\xdf\u007f\xbe\xea\xc2o\x84\x86^\xe2\xb7W\u07e9\xe0\x04\xe9o^\xb0\xa9\xf9\xd0k*\xd9\x18\xe2\xf3F\x11U2\xba\x1e\x93\xb8\x80\u0407\xf3\uced1\x17m:!;\x05\xa1A\xef\xf9\b\x87Q\xb6I4&U\xc08\x1a\x13=J\xd3\u0788\a\x0e \xeb^_d\xc0\u0682Y\xae\x1b<\x98\xa1\xec\u068c\xe6\xd7\xd8\x15]\xa9\xf4\u046b\xad:\r\n\xef\\Q\xb6\xfa\xe3\x8bO=}9\xd1\xf5\x9d#\xacO\xcc\xf1\xc7\xd9\xf4\xfc)\x13W\xb9/U\xb8/\xed\xaf{\xa1T\xc0\xf1=}\x13\xc5s\xc2S\x9d\u0160\xa3\xba\x99\xc6\xdf@[\x9blr\xbd?\xe0\xf5?P\x8f\x8c\xe7\x0f\xff\xe4\xd1Q=}\xb2\xe3\x8fD\xe7\x1e\x8eX\x85>\u0236\x80g\x03\x98\x01\xd87\xd1\xf2!aZ\xcc\\p|\x90\xb6_\v\b8\x83Kc\xfe\xf3K\xdd\x1bO\x81\x83K\u05ac\xc1+\xbc\x83\u026c\r\n)w=Md\x97\xf9R=@J\xfe\x96\x03p\xad\xb7}#KS\x1d\xedI\xc5\x14\x8d}\x13\u02fa\xc0\x85\x03~m\x10\xaa~J1\xa6\xba~)Y??W\xe1\x9f\x01Z\xbaLn\x98[\xd5\xeb\xe0\xd4\x0e\xc1\xbe\xf3\x99\xf7\xa3\x06d\xf7\xf1\xf03\xc3=I\bt\x1dc[@Gya\x18\xf8\x1d\xb7\xe9\x87\xc0\xdf\x06\xe0\x87\x9dt\x90\xf2\xd6\xc3`\x02`\xbf_\xbd\\\x95\b\x1c@\x03ck\ud234\xf4w\xe7\xe4S\x9a\x81\r\n\x15\xd1ydn\x12\xaa\xdc\x14<\xde\u007f8\xe2\u007fh\x81.\xd0\xc5\x18Ql\xab\x19=J\xe4\x02E\xf8NT\xbb\xef\u05e7+\xda\xfdo\xda\xd7s\xd2S\x87n\xeb\u007f\x15\x93\xbe+\x99\xfc\x88c\x04c<\x01j\x15p=M.\xa2j\xb0\xa6]\xbb\xaa\x0e\"\x04u>\xd8&Gzw\xf3KK\xb1\x06\xe4\x93\x18\xc9\f\xf6hh\xd0q&\xaeHG\xbd\x8d\u0726\x8a\x0f\xa3\x88\xf9<GN-\xb2\xa4\x1a}\u1a55\xeba\xdd@\r\n\x95\x1aO\u007f\\u\xf4\x8e \xa2\xaf\x17\x94y\xc1\x02\x98\xe5'LC\xee\x04Y\xb9\x88Q\x04\xbd\xd9{(2\x8bG\x1cKJV\xe9\xe0H\xe7.\x9cI\xd0j\xb0y0\xaa\xab%3:\x03\xfb\u007f\x0e\xb7\xac\xbe\xe2\xe9\xc1\xc9\xe6\xb1\x0e\xee-\xb4\x86-\x1b+\xdfQ\x1e\xba\xf3\xee\xd32\xec\x97=M\xd3I1=I\x16\xfa\xf2i\xcea\x1aN\x91-\xa2\xb8\xfax\fyA\xc1#\x11\xe1y\xad\x01\x19T*\x81w\xfc\x1ds\f\xce\r\n\x19\xb8\fA\u0647\x1c\u6267\xe7{hi*\xaagj0`|\xe37\xe1\x1e@\x95\xdc\xee\xf0D*\xd9\xea\f=@$\xe14@qT\u048c\xe6\\moOn\u0357\xec\x04\u0497\x86\xe6\xa7\x1d&\xff\xbd\x1d5\xbe~\xc8\xf1\xaa|x\xcdo*\xe65:T|\xef=@\x83^4\u0791\xf8B\xcaTV_w\xd8\x18\u063d\xdam\xec8\xe36\xce;=J\x93\xe9\xe6\xe7\xe2.\u0494\xb4\xba2y\x12\x97\xbdC\xd6\xf7\r\n-T\x04@\u076c`\x1d\xa7\al\xb6\xd7\x05X\x19\x1f\xc3\aq\xf2\xd5y\xfd\xd2\xfdy\xbc(B\xea\x95\\\xc9X5R\xb6\x82\xeb\xbe\x11\xb3\xb2\\\x8d[u6\\\xc4\x1b\xdbs\x10V\xc3\xfb\x8e\xeb=M\x9b\xe64\u0745\xbe}\xe5O\x18p\u0201\x0f\xae\x0f4+\xf0\xe9\xdd.k\xf6\x1f\xd4\xd8\x01\xc4W\xad\xf1\\\xe5\xa6\u0213\x89FF\x10,\xb8\xfc\xb4\xb6I/\xd1Vkk\xebs%k{\x9c\xec)\xe3\x1c\xa9\x18]\x88\r\nmX\u03fa\xef\xaf\xcc\x0e>\xa7h\xab\x9b\xa3'od\u0751\u007f\xd2Z\x81\x8a\x12\xf7\xd6\xca\xf5wt\xed\xea9\x04\x8b\xf2\xad`\xa16\xfb\xa07\x10\xdf3\x8blB\xb0\x93\x04k\xfa\xc5z\x84V$\xecG\xa9\xe9\x12\x84!\xb3\xb9-\x1dS\"\x02\xe1\b\xc6H\x89\u007f6d\x9da\x90'6\xd2\"\xbe%N\u00a2\xb0\xc7\xc3}\a\xf7\x1c(z\xb6\\\x18\xf9fes\xcck\x8f\xbdRV\xc9`[(\xb4\u00c1`\x0e%\u013a\r\nJ\xf5j\xdbvKY0x\xb4G\xa3\x9e\xc6*pyI\x93IF\xa2\xc6R\x94\xe1{\xb7\xb4\x1c.\xff\xca\u03aa\x05\xad\x02\xa9RY.qNa\xdc\x1al\x80\xb8\xb7\x1e\xf3\xdf\xc2ih\xe3\u06fb\xf3\x9a\xed\xcc\xe0\xe4\xf8U\xbe&h)\xa8\xa6&o\xa9\xa1-\xa4C\xea\x05r\xb6\"\xf0\xf0\x95\x87ehp\x1c\xf47l8\x90c\xbaA\xfdZ\xb4\xde[6\xb2\b\xb3F)\xe1@\xdbV\b\xcc7\\p\x8c\x99f5\x9e'\r\n\x05\xff\xd3|\xebF\xd4K\u0712%QVg\xf4\xe0U\xe2'\xfc+4\x1d\xa8 `\xc6Q'Eu\xe9\xa2\xc2\xcfi$T\xc1\b<\x16J\xc8EG\x8b\u0114\vSSA\xc8\x14r\xa3\xc4\x02f\xff\x01u\x86\u06e3\xe8(\x17\x94\v\u007f\xb2k\xf5\xd6\xc0\x11-\x17,\x8f\xe7\xec=}W\xb5\xd6\x15\xb5B\x9f^\x9f/=My,\xafP~\xc3\xd03\u01a8\xe5\x0f%=M\xc8\x19\xee\x8dQ\x18&E\x01\xd7 \xf5\x8d~\xcd\r\n\x88c\xdbAc\x9b\x1e\x95\u0544OY\xad9\x90\x92y\xf9X\x85\x87\"Cxcji~>s\x11#\x05\xb5\xb9\xf9Yy\u0207\x9ex\xf8\xf8*\x80\xa8d\xc5\xeb\u07ce5\x81.\xb6\u007f\x19\xf4\x03\xe1\x1c>\x06\xd6\xca\xcdd\xb8\xe5l\xd1\xe0\x9e\x8a\xe0\xfb\x10\x069\x05\xc0\v<\x06SK5\xa6\xb5\xa5\x9a\x92\u7c82\x14<3\xe9!\x91Wx\x8b\x1e\x90\xadB\u111a\xd8V\xe9\x02\xda&T\"\u02cf\x98$mU\xa22\r\n#\xf6\x1c\u0302\xd1\xd0f\x82=@\xc0\xd7$\xac\xf6\x89\u07ac\xe1-\xe6\u049f!-\x80C\u0763u^j&\x13\xfc\xd9\xd3N\x1f\xb9\xe9|\xd6m\xee\x98\x14t\xa3M\xf5\xb2\xebQ\x1c\x02\xe5\xa2e\xd5@\x96| J\x81\xf4g\xa87\xdf_TY(\x06\x15qX[,Q\xb6\x86`A\xfd\xa6\x14$'\xcb\xf7F;\u047f\xb5\u025b\xe7\xaf*\x97\x19\x16\xb3\xdc\x1f\xa3\xd5\xc6\xectV\u672c\xd4Xm\xf5\xc6\x1e\xb8\xe4\xf6\r\n\xed\x8eE\x9a\xaa$\x14\u0723\xbd\f\u0221i\xe5\xeb\x1e\x9f\xa2\x8d}\xbcw~\xc7\xe9\x9b#\x90/~=}\xea\x0e`\xac\u007f\xbd\x97,\xd4\xdf\x18\xa2\xde*~h:\x9d\x8d`\xfc1'\xa6v\x16\xb3a\xb96\xbb\xb3\x9e\xc0\x13\xf6=MUU\xfb\xe4\xd5\u045ckS\xe9-(\xe0\xe3\a\xac\xc5\a=I\xc6\xc0a\xcf\xe2\u0662!\x8apL\x80\xcf=@\x95-\xf0=J\xb8\u8e2c\xd9\x037M\xc3\xe7\x04\xa2\x9c)t_\xe8\r\n\xd1\xfd\\\xfa\x8e\xcc6^W\xa8e\xfa\xce6\xb2<\x95*\x1b\xfc\x0f_\x84\x8c2i(*u\xee\x8a\x13\u007f\x1d:\x93%\xb1\xd9\x1b\xf5&\a\x92\xa0\u0350\xb1R\x1c\xe5\xea\xa5!-6\x98\xb0\v0\x86\x8c\xf2\xbc\xc51\xe8\x82Wa\x99\xe3\x17\xe0\x8d\x9d\xf9o\xcf=@\x0fx{\x04\x9f\xf5\xb4\xfa\xf7\xfcXlv\xfe\x9cD\xe3\x02\xcf=MV\v\xac\xa7\xe3\xc0\x99k\xf06\f\x86%<\u03b3ped\xbdC\x9b\x11?:\xc5\r\n\xbc\xe4>\x92\xf1\x8a#\xb7\x8eb3\x1aU\xf84\xfc\x87,\xc8A\x0f\xe9\xc5!~\xef\v\x95\u04df\xf2\u007f\xdaCg\xc1?e\x94\xc31\xads\xb1\xca\u0420\xbf$\xe8\xd1\xfdxi\xb84\xeao|V\x85\xa9D7\xdd\x0e\x80\\\xf3\xd7&'P\xe1\xbc\xc0~\xd3\xc5\xfb\x98u\xc0&\xc4G\xc6\xf7\x92oO\x99\x85\b\xbb\x9e\x13(\xc0\x1f\x1b\xd7\x15\u0579\xb8\xc7A\xf3\xa3\xaew-\u023b\xee\xedN5\xec\xde\x11N\x9ff0\x16B\r\n\xbd\xcap\xc3H6\b@_\x80\xf8V\xc7F\x82M\xdd-\xea\xb2\xcb\xf4D\x12=J1\xa6ql&\x1d\x15\xd6\xd2M\xc9g\xbb`0\x94\xd1o9\xd8q\xaat\xebj\xaf\xd7\vM\xb57I\u392f\x9b\xd4\xc5\x1f\x81B\xff{\\k\x90\xe2)?\xd0\xfagF#L\xf5\xa8r\xf0\xc4\xd6\x01\x80B{kH\xebS8\xd1\x19\x88\x03\u0353\xffS\xf8~4':\xb6_\xe47\u0256\xf9tq\u00c7\x96\x81\xba/\x035\x93l\r\n\xad\x84@\u007f\u03df\xba\xb5\xa8\xee\xf0\xaa\xdd\xda\xcc\u053fE\xdd\xea\xf0&(\x90\x84/\xe1)hEm\xb1!\x81\x0f\xea\x8fq\xe2\x01J\u0382L\xe0\xd6.p\xa0\xf9\xf6!=M-\vBK\x8d\x85\xab\xaa\x0f\x98\xcf\x02\xd7 \x15\u03b4\x10\b\xe7\x85w\"j\x05\xfa\x9f \xf6\xd1\xff\xc3\x044\x9e\xcan\xaa\xd8\xfcJ'\x8d4\xf7\x8f4\x84\xe3p\x1d\xf0\xa9\x84s4h\xe2\x11\x1e=@O@\x06\"E2\xe2\xaa\xfe\x93\x13\xf8\r\n40\xf4\x9e\x0e\xf5\xee\xffylg\xc2\x03\xed\x06\x9d\xb3\xc1/\x90\xe62\xb2=M\xa3\xdd\xc3[\x83\xcfN\xbc\xa2Q\x92if2\x97If\xdf\f\xb8\xc4N#aQ\xbf\xd6\x16\xbc\xad1\xe0\xc4\x03\xd2\xcc\xed>\xd8*\x96&n\xa8\xdb\xcc\"_lOv\x1c\xe9\xf9=}a\xf3\u07f5\u06ddG\xce9\xaev\xad\xb8M\xf6\x8f\xea\x95\x04\u04ad\xe7\xa9\xc1\xd0\xd0)W\xe3+M\x96L#\u07c4\x8a\xec\x14\xd70\x01@\x8do\xf3O\r\n=n\u07b2=JK\x15\x867,i\xb39\x9b\x81\x98t\x1a\xe3\xf4/\x82\xc3\xf6\xa9x\x10\"\x05\x8d\x17=I\xa8\x1c\x99\x97>Q\x81\x04\xffJ\xfb*xp\x92*\xb2No\u5f6c\xde\xc7\x19\x8eD0\xd6-\xd5{\xc2p\x16\xb1\xdc\u00bb\x900I\xf8\xd7VE3=IGZ\xa1p}\x01\xc2\xef-\x99[\xd6\u00b0\x188f\xe6b6\x1f\xe8Bv\x16\xf3\x92\v\xf7\xfa\x8d\xce1\xfd\x96\x10\xe2\xa8\xea\xd5VZ\x8dB\v\r\n\xef\x8c;\xf8\xb2J\x8c;e\xe8\u00b2\xaf\x18\u03b8\xdd\xc2qV\x19\x05\xd5Q\u066c2pC\x91\x86\xfe0;\xea\x8a\xe8\xf2\x9e\xb6\xca\x01\xb0\x02ojoV^\xec\xfe0~=J\x90\xfcp\xa3]}\xabMU\x1d\x10\xec\x86)\xfd\x04\xf9eD\xeaw8\xef\xd4zE\xe7l\a\xf3\xa3#(\x13\xb5\xb5y\xd6\x14G\xc1O\xbc\xf7k\xf0\xe7\x11}\x15\x18\x9c\b\xc3+\xca4\xae\x18\xe7\xed\\\xe0\xde\x02\\\xa5\a\xd3\"K\u017a\r\n\x88\x9ez`F\xb9;\x8aqX\xa8 \\F\xbe^W\x95\xf1=I\u007f(\xfb\x84\u02adO\xd6\xe2\x9f\xf5\xfc\xd5y\x97\xf8\x1e\xba\xe1\x8f'\xde\xf7\xafm\x04`\x8d\xa8\x05\x9bl\xfeLz\xc07/\x19\xb7\xab\xea=I{\xa4\a\xdb*\xafy\xc5B\xfc\xd3\xf0\xd3g\u00f9\x11\xe3eu\xac(\xfe7\x8c\b\xc97%{\x90\x9c\xdc\u0719\xc3\x13=Jh\x99j=Im\xe3\x8b\u007f\xf5\x87\u036b\xf25\x81\u91d0d\x96C\xb6\xbf\r\ns\x17\xf1\xeb\xdf0=IWg\x15\xdf\x10\x8b\xa7\u007f\xa5\x02\x93\xde\u05ac\x99\x19d\xb4\xdfYt\xa6\xaf\x15=Jm\x13d\xf2\x8e\x02\xf9\xbdN\u0772\x19nm\x81B\x8e<\xf6@\xe3rv|\xabm\xe5\xdcs\xb6\xe2\xcb\xfd8\xa7\ud55d\x018\x04\u0615\xb1\x97\xb9$\xd2\x02\xc3`]\x1f\xdaqv\u007f\xd1\az\x01*y\x8fR\xe7\xb9\x177\u05b3\x13\xc3}\xf1sz\x04\x0e\x8e\u00b8\xfc;C\xda\x023U5x\xaf\xfbl\r\n\x1a\xdd\xe9\u007fUl_b\x88\\\xd0\ag\xaa\x04\xabt\xb3\x18d,\x94X\x86\x1c\xd4\xde\xeb\xb3\x17\xbe\xd6\xe5$=@\xcb@v\x9a)\b\xce\xe9p\xca\u039f!\xb5;\xb3\x97_fzT\u00ce\xb4\x9a\x87\xf0\x81\xe5\x97\u011br\x99\x98D\x9fV/\x9d\x8b\x01\x94\x8a\xd8W,\xd6\aFb?`Bvw,>\x0f[\x9a9r}q\u044bE{0d\xb9t\x04#\xbc\u5be8\xe5\xd1\xf8a\xe2\x13\xe8\x1d7b\x80\x99\xbc\r\n\xf3\xd8\x04w\xa7\xee>0\x97H\\\xc3\xde?0\xb7\xc0=I\xe3\xc3\u0278'3\xdba\xe1&@\x17H\xee\xdf9\u019e\x84=}\x9f\x94\x87\x9a=Iv\xb54\xfb0\xdb\ud61d\x89\x9a\xa2\xa3\ud51d\x0e\xfbd,\x83\xed\x9f'\x88\xe8L\x026`\xfep\xb3\xabt\x9b=}i\xf6?=}\xb0\x19\u0141\x97E\ub75e.I\xcc\x16\xd6\xec\xde\x06\x13\xed\x018n`\v#\xc6Y\xf6Zj\x92\x90\xf8\x16\x062\xa2b\xc6\r\n\xad\x89\xe0 \xa7%\xe3\xaf@\x01W=@\u04a7\xc3\u057e\xaf\xc1S\xf5GZ 8\xb5\xaa\xc4\x19\xb4ri\xc7\xf2o\xf2=M\xbb\x97n\xf8\xa6\x94OM\xec1\x12\xe0\xfb1=Ms<\x13\x9b=I\xd5V\xec<v\b\xd8y2'/\xe2W\xc4N\x96\x86\x0f^\xe6\u05bfh\xf6\xe1\xe1u\xb0\xa7\x9b\a8\xd0\x1c\x05\x05Py\x1c\x83n\x1c\xf3fDZe6\x82\x8a\xee\xb2!\xb0\xee\xc2\x19'\x17\x81\x1b!\xc9\aO\xaf\xa8\r\n\x8c\u07a3^\xeb\x1e\xe3L\x12,\x95\xbe\xf8\x16k\xe0+\xbaX\x89Ov\x18\x86\x98\xed\x9e@O\x06\xb7\x11\xe4eM'\xaf[E\xa5\xfdZ\xc4\xf10\x1b\xb64%\x95\x11\x90\u07fa]\xc1\xa3J\u06db=Im\xbe\x0e\xed\xf9cL6\x05\xec\xdfl\v\x83\xd0=M\xccoQJT\xb8\x9f`\xa2b\xba\xa0\xab\xd3aJ\u019b\xbf\x1a\xca\xc5sX=J\xc8o\xbbsd.\xc1<\xb2c\x1a\xb3\xe6\xc1k\xc9,\x87\a\x9f.\x80\x1e\r\n\xcc,\xa6\u007fU\xe6\xb6\x13\xfb\xaa\xae\xf4\xbb1h\xc9\xf8=J\x17G\xba$\x86\x8be\x97\x90.v9N\xfc\xd6\u0748)\xd8\u03e2\xd2d2B\xe3iv\xb9l\x15\xa4C\u0290\x19\xff\xdd\x0e#\x95@\u007f\xcb\x10\vP\u4633k;=I\xbe\xb7`\xbai\xaa\xd0l\xc7&\xac\x8a\x06\x92\xab*\u072126\xac\x15T\xe5\x82\x11F\x9a\xf1\xd5=IX\x9d8\u062cS\xe6\vg\xb1\x98\x11\x944\x8aV\xaa\x83\xd4(\x1f\xf9\xef\r\n]\xc3&5\x05\x10\xf0k\xa6we\x92*\u07f4\xfc.\x05z\xc4\x02\xe7\x80\xe3\xf5/Mob\x84j\x11 \x89\x88\u007f\xa7\x0e\x8f\xcc \xad]6\xf49\x03739\xf8W\u007f\xbd\xe7m\u008d\x19>\x8d\xc1\xa2\xaf}\xb2\xd0jd\x8f)\xbf~\xe3D\x89\x10\x94\x85A!\xe09n\u06a8\x15\x92\x96j0\xe2-\xf2^B\xf8\x03\x9a\x04\x19-=J\\\u044d\xd41\xcd\xe8\x82\u008dh3T\x81\x1b8\u05fd\x92\x9a^*Ea\r\n\x83\xb4\x0fsV\xfd\xc4\x17\v\xed\xa78\xd03\xe3J\u007f\x10&P9P\x12\x90\xacF\x0e\x19\x831\x8b\xe3\x8fuz\xb8\x96f\xc1\xa9eF;\xc7\x16e=J\xceJ\x1c*I\x99zz=MTM-\xa1y$\xfd\a\x87m\xfb=JX\xe5\x05_\u05e5\xe5\x03\xe7]3\x94\x1c`\xaa\x956\x17\x06\xfcw>;\x11\xc4\xc6\xee\x9e\xd8=I\xffd=Il\x05\xee 34?b\x1aw\x8c>\xcb\xdcZ\xea\xc0\x9c\xa7\xa0\xabF\r\n\xa1b\x1b\xeaC\x81u;2\xa3\f\x97\xa7\x90Zub\x9d\xfcI\x14#L\xc5Y\xf0\x8e\xc0\xf6\x90=MS\x89~x&\x98z\xccvg\xc43\x13\xcaf\xe4\xe4\xbcUA\x9f\x0f$\x88\x88\xfel\u03d2\xccv\xfb\xfa\xf2\x9b\f\xea\x83\xcc\u0466\xbc*N\xf6Fi\xac\x0e\xba!i\x04\x8e\xc8$\xca\xdd\xf6]\x97\xabhO>\xc7j\x8fk\xd7k%\x8f<\xef\x12Gz7v\u9607\xbaTV\xb5rn\xca\xdaVQ\xbe\xf5c\r\n\x1f\xbd\xb3\x8b\xee\x90i\xf3\x06\xfb\x8e\xec\xf0\xc32\x1do\x8e\xeb\xbd\xd8c\x9c\x15\x85\x06\x1e-9\xbf+\xb5\xfb\xba?\xdeJOw\x9c\xb5@nR\x94\x03R\x03\x16\xa7\xc72\xbf\xf3\xb3\\\xbd%\x12\x8e\x1b\xe9\x9c\xf2\xd5+;c\xb4\xdf\x11\xbc\xeeM\xaf\xc3\xfa\xb4\xa9\xa3\xaf\x0f$y\x15\x9b\xc4v\xd1\xef)\u0366\x1f6GoHh\xbc\x16\xf3!%\xf8\xfa\x19\x91mz\xf5\x1b=I+\x92\xcco/\xd7\x10\"+`\x8b\xea+\xbf\r\nu\xe7\xa4V\x87T\x04\x98Ce=J=}\xfc\vR3!\x06\xa8H\x91\xc8X\x04o\x87Xk=I:\xbe\xba\xf0\x8a\xef\x10G^\u023f\x1b\x9f\xa1\v!\xab\xbdxg>&\xec\xfe\xa1\xb1m\xc9w\x9d\x01x\xf1#\xc6n\xeb^\xe0\xd1U\x85/\x85\x1b=M\x86\xe87q}u\x1b`\xee\xda\xef`\x99\u035f\xbb\xd1rs*\x14E\xbdC\xfb\xf1 \x92h\x8d\xad\xee\u1719[E\x8e\u0772\xb9\x80\x99\xadU7PxD\r\n\x8a~l\u03df\f\b\u0798\xa9\x01\xb1\xd8\u063f$r\xef\x1f\xcb\xd0z=@\u0358e\xfc|_\x8e\x97\xc9\x05K7\x84!+\xbcf\xac,\x96=}\x13W\u02fcf>\xd3\xcf-\xafI\xba\xa7\x0fz6\x9e\u0302\xa5d\xe9\xa8.\xb9\x1a \x8e\xc1\xf4*}\x1e\xc3\f\xa2\xd1{\x9b\xe6\xd7\x02x\xf0\x92\xb7\xfc7\x9d@\x11-\xab\xfe\x03\xb4\x82v\xd1L\x83\xc5p\xa1;w\xcfG\x13\xb3\x0f\x12\xcd\x06\xc7D$\x02\x13\xbd\x03\x96\r\nr\x11\xecx\f\x92\xdaXM*-n\x85\u01da\xd3\x05 ,9';\x92\u0695\x05\xc8E\xe0K\u632a\xca\x0e\x82~A\xe7#\xd4iL\x03\xbeKuv\xdc30R~\xeb5?\xb0\xa5\xdc\xc7'\x97;\x9f\xec?\x16\u007f\xdboX\x82\xf3f\xa8R\x85\x86h%y\x80\xf1+\x01\xef\xdcD3=J2\xbfw\xcbK\x1c\xb1\x97\xb4\x83\xfc\x90\x99\xf1p\xba\u022e\u073a\u0694\x8e\xaf\xa0\xa1:e\xcf\xcb\xc08\x0f\xca0C\xad\r\nz\xf3=}Xw\u368ff\xfa=@\xe6\xee\xc1a\x84\xeb\x02\xbe\xb7|\xc7=@\b\xf0\xf6\x1fmT\xc5b\x98\xd7E\x0e\xefz\xee\x8b$\xc9\u06d6\x84\xa1qR++Z\x1eHD\xaaSlO\x90\fx\x1c\f \xfbS\xdd\xf5\x90\xf5\x8c{\xb7n$\x85[v\x9e\xf5K(b\xedOF\xf8\xd0\x1b\ua1c2\xbd\u00fb\x05m\xd1\f\x99OK\xa5E\xeb1^z\xac\xc8\x05\u03b9y\xd2^\xf0\xe2\xd9\xda\xde\xe9\x93s\xdc\x17\r\n0\xfd\xac`O\xab\x1a4\xd7\xdfW\xb2\x9b'+\x0fP\x94\xcd\xcc\xfa\xce^\x91\xd2n1l\xb9\x1c\x9a\x98\xc9\xf8\x19\xe9\u033c\xec\xe1\x8bo\xcbak\xcb\xc9\xf4\x95\x81\x1e)\xa4x\x99\xc3\xd2\xdc`J\x1eCT\x1fl\u0672\x06\xf4W\xbfm =Iq\xe5\x8e\xf7\b\xa0\xe4`\u20baM\xd2\xe9\u0580\x84M\x85\xb3k<E\u0285=}\xea\xf4z\xf3@~b\x0e\xdc\xfe\x12=J\x16\xe76l4=M\xa6\x99\xf8,$I\r\n\xfa0\xca\xd6=@\xc6\xe1\x1f\xbc4\xba\x91\u043e0\x8fj\xa8\xe8=}\xf4\u007f\u065a\u04fcQ\xe7uE\xb5\xec\x11`\xcc\xd5CTy\xac\xfe\x8d\xa3\xc5\x19\xd4\xd3\xe5Y\xdd7>u\xaa\xab\xd4}\x1d\xf3A\xd3=IW\x04\xad\xe1\x89I\xbdTue}\xc7\xc4\xc5\u00d77\x12V\x96E\x80\x18\u020e\xbd\x9c-\xe1\x8c\x0ez\u04a6\u02ba\xe8i \x8a\xd2\xee6Z\xda\x06\xe9\x87\x0f\x8eH8\xb3:=@K\x1e9\xb4@!z\r\n\xa9\x88L;\xecTK,\xee/\x85\x8b.\xe5\x94\xf5rs\xbd\x90~@\u036c\xa3\u05fbZ\u036c\xb9W\xbb\u06bf=IS\xbd\xe2\aK=@'8\xb4S\u007f\x1d\xea&\u007f1O\xa7\xad\xc0\x90\x91\x12\xa6\xa3\x05\xc8\x15\u007fX\x1c:\xe4+\x1f\xf4\xf2\xa5H\xbeGZ\x84\x8f\x1d\x85\xff\xb26,,<b\xa67\u0588O\x99p\xc3\xe6\xff\x86,e\xba\xdd\u0086\x10,\xf2\x88]\x14\xa6(-\xee3I\xf0\x19\xd9#A.?\xbe\r\n\xa5\xd8PR\x8f\x1cp\u41b0\xf4\"J\x19O\xa7v\x9d:\"\xcb\xe6x\u3540\x13\x9f\xd8\xc2\x1cd\xba\x1d\xc0\"Qr\v\x8eE\x8a\x9c\u01d8\x99\xb5A-=J\x95\xaeAET\u07ed\x18\xa1\xa3\x11\x98\x87\xa8\x06\x05\xb1\x8a\u03c0\\\xd8\xcf9\x83\xea\xb1J=@\xe5\vm<c\xfa\f\xd2p\x87\xa7\xb1\xdcN\x82!e>f\xc5\xf1ZT\x97n\xbb\x12^7,\x17\x8d\xae\x151t\xe1\xa50\xab\xfa\xfc\xad\x82\x01!\x92\r\n\xc4\xfa\xfb\x95*\x02\x85\xe1\x15\xacjj\xd6\\F\x95\xeaL`\u007f(\xb0n\xa4\u9696a\u0251\xbfV\xc4\xdf\xf95\xa6\xcd=J\xb3:\x12\xdb=@\x1bn\xa0S\a\u007fG\xff\xe7_k\x8cJ\x1b\x99\u0303\x8a\u053e3\x8c`\x9c\x1fH1\xba\xe7\x01\x86\xfa\x02\xbd\xf3\x9eX5V\x97\x17\xed\x1d\xb5\xf5M\x17\f\x1c&#\xf5QA\x04\xfb\xfd\xcd\x11\u0645Yd\x92S\xa2\x1d\u0675k=Jj\xf8W\xe7\x18\xees\b\xf3\xbd\r\n\xc8\u01b0,7\x0fx@kq&%\xf8,^\xd8K\x10\xbb\xe3\x99\xcbn0\xaeJ\xf4\xfb~=J7\xb5PR\x84>\xdc%\x1d\x15\u0722\xadSR@\xa4\xa2\xb5\x1bB\xbe\xad\xba|\xe5\xe8\x84!\xf7\xef\xf5\xcb~3ak\x04\xbb\xc867\xdf \x80\xd8v\x15\xa8h\xa5\xaf\xe94|\u01a8:\xe6\xceGr\\\xd8\xc4\xe8\xf3\xb7\u04192\x8c\xf7@>\x0e\xf2\xc6x\xf1s\xe9i\xc7\xe4^\x1a\u02a5`\xb6\x86\x94\xe3\xd3?\xf1\r\n#4-\xfc\xe4\xf3\xfd\xa6\xae\xb9n\x1f-\xfa\x90e|\u0766}QcH\xa4\xa8W\x89Q\x16\xadh\xed1Q\xbahR\x89\xa2%\a5\x17\xef\xcd}\x1d\x02\\xI\xc5Q\xf0\x0f\x1b{\xc6N\x9b=J\xcb!J\xed\xa8\x1fV6D\xbbd\xce6i3\xab=@$r\xa9\xb0q}\xc7?I+\xbc\xfd\xf5q=I\x16\x90\x01\xe4\x14\xfbyZ\x93\x0f\xc2\xc9fc\xe7)\xfb\x16$gH\xf7\x8b\x1e\x1c}\xcd\a$\xd89\xa7\r\nvx\xb9Rc\xdbx\xd7%IF\xc8\xcd3\xd8\xedW{\xa6\x90\xd2\u007fY\xc9\xe6\x1b]I=}\xf5\xa2,\x06:\x1f\a%IG\xa6G=M\xfb\xd0Ke%S\xce\x16a\x19qi\x10]5gW\x92\xa4T\xd6\xdc\xcegW\xd7<\x8e\u070b\xb6\xd1\x02\xafh*\xd7'\x9e\x12J\xfc\xf1mG\xf0k\xb5\xd1Ba\x96\x85\x15\xa8\xa6\xa7\xaf\xd1:\xc5\xf29\x8d\x1d$9\x8d\xa2}}\xd1k~\xf4\x89\afL\x84!\x1e&E\r\nHmSW\xfe4\u0396p\a\xc1\\\xcc\x1bj\xfeh\x11\x0e\u0356#\u032eGF\x8d\xaf\x80\x95\x9d\x05.\x9b\x8a\x93\xc4\x12vI\xb7a\u0182\xc6\xcf{\xfb\x028\x85\xc0uV\x80\xf9\xe3\xe3\xa1Rlr{\xac0r\u0643;\xb2\xd5\x0eJT[1\xb4N\xa7\x03\u074e\xa67[z\x0e\xeb\xbbF\x1b\xad\x157!\x05-\xbf\xbb;F\xaaav\xf5Z\x14\xf6\xbb\x8b\xbe>\x80\xf1\xa2\xbe\u076b\x12\xea\x9ez\xb7\x87p|&\xf8\r\nt\xdc?\x840\xd2\xc0\xa8E\a\xa0\xbc\xbdT\xff\xf2:\xcb\xe07\x9e$5Q\x0eL\xaaD\x03\xff\xb4\x9b\x19\x98\xd2\xe0;\xe6]\x84R!\xf97\xf6\x0f\xec\xd3\xf4\x19\xc0\xc1\xea\b\xeb,\xeb\xee\xce?y\x8b\x83H-\xebu\x91\xee\xaa\x12\x9d\xa7\xe3\xec\xe1\x9e\xed9V\xfc\xa0V\xb0\xfcN\x98\xb8\xe0\xed\x9f\xcdw\x86=}/\x8e\xcb%\xec\f\xe7\xba\x15\x1fw\x92\xc2x0\x1ba#\u0232\x1b\xcb\x11rw\xf5\"\xd1a\xb3\x05E\r\n\x9e\x8aK\xfe\x1a\x0f,\xccd\xdd\x03\xe5\xdd5>\x81\x89\xd71=}\x0f\xe9\x99:\xff\xfb\xe4\x1d\x01*\x8f\b\xcf\xf9\xf8\xf6\xf3\xbe\u01c1\x88\xc9R;\xc8w\xca\xf6\xf9Ur\xf8\xd6%\xa3/oz\x1d\xbf\f\\|W$\x18U\xf2yG\xf2c\xe1yBe\xef6>\x8c[p\x9f\xec\xd4\u036e\xc6R\xef\xca\xe4\xea\xc3JX\xc2\xd2\xd1p\xd3/\xf8\xf4\xc2cd\xdb\x1c\xae\xa5x\xb2\u02cd\xe4G\x05N\xb0@\x18:]\x1cT\xfb\r\n5\xe5V\x19\xd1\x1c\x8c\xcb_\xbb-\x10\x1b\xcbl9\xa0\x17\bnJ\xdd\xccb\x83\x1b\x0e\xd4&\xb9\xe3\x99\xfc\xbd\x19\xd9c#\x12\x9b\x04\xe3\xc2n#*g\u007f\xedk\xb6\xcbw\xf3b\x8d!3\x10\xcc27\xd4\xefT%%$@W\xaa\xe8\xff\u0243\xf8\xf1\x99\xffP\f\xb7\x14\xf7\xa5R|\\\xed\x1ff\xce\xfc\x17\xfa\xcd\x12\xdf\\F\xd1\x17\x8a=Jwe\x1a\xae3gt\x8c\xa5\xb6\b\xd7r\xd3S\xbbv\xe7\xe2\xc0\x99\xb5\x8e\r\nh\xb2\x10O\u06fa\xcf_J\x86\xc4\u015b\xedt\xb1(4a~=J3\"\xf4\x9c!)t=J\xb3>|P\x0f\xd7)`z=J3\xb47\\\x0f\u06af\xe3O\xa3\x96\x03`\xdfG\xa2L\xff\x184\xa3^\x06\x97t\xb1\x81\xf4|k\x02\xd2\xcc\xed\x95J\u0114\xbenU\x9e:\xce\x04\x87\xfc\xea'M*\xba?\x81\x17h\xbf2\xaam\xc7*\x8c-\xcc8X\x12(\xb6*\xa8P\xcb\xd5& \x91<\x84\x10\x83\x1f\x83=I\r\nI\xba\xaeL\xbbm\xf7\xe8\xf3\xfae\u075c1~\x8c*/#\xc4O\xae\xe8W\\\xdf\xe9ulE\x16\xd0\xce.\xc2\xe2\xef\xe3\x87\xf9\x9d\xa32\xc1\x1e]\xfb\xd2\xf4U\xd8\u072b\u0242\x81\xc3:\xbe\x8d\x81\xa8\x8c\x87\x85\x8a\x87zN\xfb;5_\x8a\x95\xc4E\xf1k\u05aa\x1c\xdc,\u007f\x9a\xb5t\x1b0S\u049f\xac\x10\x05\xe3>8\xf5^\x1e\x9b\xf2=I\xce+\x10p\x95\u063d?}\xf2I:\xa2L*\xfb\xe3,\xc8L?\xac\r\n\x84\xa4;\xac\x8cT*J\xea\xd7DB\x96\xbd\xe3\xc8?\u0117\xf4\xe7\xda7\f\xaf\xfb0\u007f\xf1\x93\xc5\xe4q\xc2=}Z\x181 \u01c7\xfa\x84!\xb6\xf2\xfb=I\xf6Z2YD\xfc2\x10\x9a\xb2\x04\xb0^\x8a\x8c*\xd3\u0116\xb5T\x83\x8b\xfc\xb76{)V\x040dBj5\u007f\u07b7y\xed\x0f0\xbe7Nn\xf8\xca\x03\x98-\xa0G3N\xa2c\x92\aj\xafm8\x84Ar:\xb5 \xe9w\xbd=@\xbb5\u0299\r\n\xb5`\xf2=@\u05b7\x1dK\xd4\xe2\x15T\x85\x85\x18\xf5\xdc(\xe0\x9f\x93 \x03\x86 #\u02af\xa7H\xb0\xad\f\xf9\xe7\xf3\x9f\x059\xfa\xeb\u0743\x1e.\xd0Y\xa5\x96\x8f\x8c\x88\xfe\xb9~Lo\xb2\x11.8\x1f\xcb1\x12\x03o\x01|\x82\x0eq\u01c5\x87u\xe5v\x02\xee4\xcc\xfd\xe4s~\x8d\xd3\xf4D\xee\xfb\xb4\xbe\u0528\xbe\x19\u0d8e\xfa\xe9\x0e~N\xa6%\xac\x9b\x92\x8d\xac\xbf\xfe\x94\u0320\x168\xdd\xc3g\xf3\xf3N\xc6\r\nYr\x0e9<\xb9\x0e\u00c7\x8fNY\x9d\xf8\x97E\x92\xa0=J\xf1\x19v\x1e\xf0g\xb0\u030f-0\xc1\xcf2\xb7:}\xbbu\xa5\xc9\xe8K\x96#\x8fV?&*,\xbfZ\x19\x10\x04N\xef,\xf5\x98\xee\xec\xe0x\x0e=J\x1e9\x01\b\x0f\x1d+%\x80\xff6\xf2ay\xa2\x03{\x1c\xba\xaa\x16\x05\x94j\x9f\xdf\x14>9a\x89\x884=J\xf1\xde\xc8iN\xe7\xf1t\u073f\xd8\xf8d\xe7\xb1=J[\xb1\xb2F)\xdb\xdb\x1e\r\n\xf9\xac\x18T\xa8(\x83\xfa\a,w)\x83\xfa\xd7U\x12\xcc\xd9,g\xcfw\xe4\xf2\u05f7\x1a\xe8\xd3B\xe2\x803\x19)\x1a\xfeHG!\x15`\x1d\xdfel\xef.\u0532\x80\a\xd6$=@\xad\xaa\xb7I\xf9\xcaj$\xdb\xc9\u7963\xc7f)$\xa0\x87l\x16\xad\xc0$\a\x161\xf33\xdf\xfa\xa0SN\xff\x87\xf8\x9c*\x13\xcfp\x0e\x18\x91O\x1d\xe5\xdb6i\xf3^\xe12j\xa9d\xc3pG`\xfai\u02d8+\x8a=I\xfc\r\n\x15xE\x94~\xc9\xf4\xbcM\xbd\xca\u042ft=@PT5\xe6;\x12Gw\xfe\xf5\u05b7\x9fR}\u007fFW\xff\x9a&\xcdS:zKR0\xe5=I\x16G<\xb6ZE\x9dK\x1e$\xd0\u00f1jE\xe7C\x9e\xd9\xf8\x89\xa8f\u01f3\xef\x0f=J\x1b\x04Q\x0f\x13p\x84-\xa6\xbc\x19\x85\x88\u02f1/L\x88\xe7\x86\x14\xbc\xe52\xa8\xb9K.\xa2=@\xef\xb0\xc1\xf3\x02\xd7\v\xbce\xba%\x9e:\xca\xceK;J>\xa2P\r\n0\xe7=@]`t\xad\xed%\x85\xec\x8e&\xd80o\x9a\u06a8\xe4\x10\xf7v\xedi\xe1\xee\xfe\xf1\xfaHz\xb0\xb3\xf9\xe7\xec\x9c\xf6\x85\xfec\x0f}R\xf6\xeb@\xfaj\u0517\x99=@\xe4Y.\xa4f,\x05\u0172\xc1\x17\xb9\x81\xed\xdf\xcbYU!a\xd1\x0fV\xf7\xc1Z\xc6kE\xc6\xece\xc2\xf5\xbc\u03cb\xa25x\x94b\x9f\x1a{j\xd4\xc4\xfe\x8e\u05f4\x1c\x1e\xe4V\x8c\xd4tW\x82\xfd\\\xbf\x05lc\xef3\xed\x15\a\r\n\x9f\xb4\xba\xebk\xed(%\xf0\x89\xab`\xa62\xe8]\x84\x11\xf2\x85\xe5\xb3\xcd.8\x84\x88m\xfd\x995\xf9\xcbz\xc5\x1a\xcbp\xb2|\xa4e\x9a\x1a@L\xdeF\x18#\xbf\x13\xec\xfd\x19#\x99\xbf\x87\xbd\xed\x82\xea}\xd2)\x9a\xef\u0637\xd6(k\x8c\x1e\x95o\x9a\u05cb,\xdf6<XZ;\xc4\x15\xb5+\xd0b\xe2s\xc2#`\xc5O\xcdcy\xf0\x95K\xcc\f\xa3\x83\xbaF6\xfd\xd2\x10\xa3\x12\xe2\xbc\x1f\xdb06\xa1u\x1bK\r\nJ=IJ\xe1&\xf6ClH\xf2\u01e9%`\xb4\xf0=M'S%v\u0313uZsGT\x1a\xa3Z\xbd\xb2]\x8c\xfc\x84\xf4hl~\x9e\xe1\xbdz\x1f\x84D\xa1\x12Z}5j\xa6\xcd&k?+\xcbn\xe7\x13\xd5iB\xc5=}\xbd\xb3\xc0\x96\xf8QyuvA,sO\xbf\x86\xb8\"\x068\xfd\xafqr\xe3bZ\f\xde\xf8@Bp}\xdfJ\xa4\xdea\x89\xf4|\x92U\xae;\xb5=M\xe9=@\xba\b\xd86\xdb\r\n\xd9]=J\xea\xf0\xe8A\xc2\xea?y\x97=}m\xca\x15S\u0169\xa2\u02eb\x9c\xee\x9aw\x90|\x05\xd4\xcb\xeeX+\x17\x84\v\xc8\xe7U\xf7F\xd4\xfdEFU0\x97\xad\x1e\x95\xba\xa4\x9e\x961CI^\xafj\xde\xces\x91\xb2\xd7\x1a\x95\xe5\xee\u0661\x19\b\x06\xa9\xa7J\xa4\xe2l\xe3\x01\xb83\x9d\x125\"\xdah`X\x10J$\xe8\u04dc\x11$c\xb2\xba\x06\xfa{I\x93\xea\x85\xde?.\xb7\xe0v\xa38)\x02\xffS\x10\r\nB\x06\xf4FZ\x02>\u05c0*6\x94\xd3}\x0f\x14\x82<\x83\xba\xbc\x91\xdf\a^\x82AE\x88\xe5\xeb~\xa1\x85\xeb\xf7z\xf0\xbf\xb5\x1d/7TM\xf7\xb3\xa7\xb639\x9c1Wo\x0fI(\xbf\x93\x83=J\xcf\xc2\xd8\x1e\x9a\u07a6\xe8\xd0\x0f!\xf9\xbeM\xf1&$\xccQ2\x0f\xfbWC\xa4=}SB\x16\xa9\x8aY\x05\xe9\xe9&\xa0\x88+\x97\xbc\x91\x8f\xac\u03a2\x06\u00fd\xb7J\xe46\xba\x1b\xa6$\x86`Jk9\xefh\r\nJ\x89\xae\x96\xcfVe`\x80Ngz^3\xdce\xedN\u01e1\x19\xe8\u0229r\u0226x=M\x8f=M=J\xa6>\xcc7\xd9V \xe9#\x11n`.\x83\x92\x8bd\xfaAG\xda%3DSt\xe8\u0243\xb6\xa9\xf1\x9a\xd7\xe1\xc9\x0f\x95J\x05\x96Iv\x885v\xb4\xe1\xfa\xb4|\xe60\xdd[M\xf1\xf1q\x84\xef\xf3R\xb2\xadI\xa6\x91\x18\xf7z\x80^\x9e\xe7\x1d\x1c\xc3\xef\xd8\x11\v\xef\x0f%w\xc96@N,\xbd\xd5\r\n\xd3C\xa1\x1f\x8d\x83\xb5\xc3\xd6\xffM+U\x01\u020c\xba\x12\x80\xc1\x92;z\xcfm\xa8ZrP\xe2nk\x8b\xb0p\x8c\x11 \x9eV\x8e\xa1\xba\x9d\x96J6\x96U.<t\x12\xef_\xb4\xc8\xcd|\xa7:\x1b\u07a0\xbcN7\xecT\x82\x8a[\xa9\x12 #n\xd8l\x18\xf2Q\xfa\xf2Il\x12\xbc=}`K\xa6\x04I\x10c\xb9\xb7C0p\xcb\xe5s\xae\xe0X\x12\x02\xd4\x1a\xdds\x8a\x04`5S\x10\x19\xa22\xc5-\x06D\xd8\r\n3\x9a/\x87:`\x1f\xbbf+\x06\x16\uf877=M\x83@3x\u04a8\x9ev\xb9\xc8\xc6Fo=M.o\xd9\xf6X\x95\x8e=M\x95Y\xf3\xe6\u020b\xcd\xd78=@R\xfa\xf9m\x8b\u007fOy)\x0e[\xecn\xc7m\b\x8d\xd5\xe9~\xc4L*J\xc5;B\x17G6\xf9\x0fEf`I\x1d\xcd=}}\x02\x10\x80\x99=J#\x12&\x1d\x87\x04\x90h\xbb\xfdT:\xe7\xe4\xc6\u02f5\xf0O^\xfe{H\u0438\x16\xcc;o\r\n\x8d\xf2\x1f\x96\xdcS\xb5\xaa\x9f=}6\xa4\xff\xff\x17+\x03\x19\x84\x0e\u007fGWG\x89\xf0\f\x12e\xbb\xad\u0535\x15\x18&\x16\xadv\u07e4\"\x83tA@w(N\xbe\x11\xca*\x9e\xcb\x19\xda\xef\x8dO7@=M\xc0\x14/\xf9\xce]Sk;:)k\xe5\xaar\u025fIR\xac\xe9\u02dd\xc5\x03\xa1\xa5,^V\xc0\x8a5\xb0_:\xb6~?\xa1\xde\xf1\xd6f6P\x91\xad/\xa2\xb1\x9fu\xa8\xe4\xa4Xlp\x872\xa6\xeb\r\n\xa5iY\x88\xadk\xd8\xcag\x01\xdd\x19\xb4\x0f_\x86gR\xa7<f\x90\x979XPrm[\xe2\xf3\xbf\xd9Jl\x86\xb8\xfc\x91&\x1a\u052f\xfaU\x1c\x8d\x94\u04fb\xc93GwE\x12?\xd5\xf9\xe9I,L&\x03O\x9f\x95\x0e\x1d\xf9\x04\xec\x89\xd7\xe0\x9a\x89\xc1\xfe\x89\xd2\u0635\x92D\xbbp=}`\xbb\xe7C6~\x1a2\u0394\xcfu\xe4\xb76gBfL\xbf\xd1n\xf3c\xa6\xa7X\u0354^\xe5\xec]A\xf7\u063c\xf9\r\n\xeb\x80\xd6\xed\xe5\u0147\x19/rt\xa9\xe8O\xe2\xaee\u0703:\u049a_\xe5\xee\xff\xa0\xbbb\xf1*\u007f\x17o:\x91\xc2\xd1\x04\xe7B\xf6\x1d!}&\x10\xb8\xb3\x0f\xc2\xffOZ\aj\xe0\xe6J\xf6\x98\x9f\xd1\u0089\xaa\x83}\x1d\xfa\x1fqL\xfd\x9d\xf1\xde\x1dC\xc3\xfc\xe2\xf9Q\x10Z \x92\xbf\xe5\xdf\xc5=@\xe3=@g$T%\xafV\xeb8\fc\xb2\xac\xd0\xd3\\-t~\x90Z\xcaP\bg!$\xbbu\xb6\xea3\r\nM\xc1\xf7\xef^\xc9\xe4z\xf9\xe0\xc3M\f\xd4F\x1a\xc5/4\xff\xebQ\x1c\x02\xe5\xa2e\u0554\xc9\xcet\x92\x16p\x8bq\x14\xa3j\x89\xd4s\x92\xe8\xbb\xeb~\x0fMX\u00f1\x10\x9c)\xbe\b\x11j8\xb9\x01HG\x8d\x8e\x8b\xbah\xfchb=MJ\xb7\u0320e\xb1\x0e\x9c8\xf2^y4\x90\x98:%\xd7\xd0%1k\x98\xd6=J\x9e\x94\xe4{\xee\x83J\x82\xee\x10\xae\xb7,\f\xb7\xf6\xbax\xb6\xe3\xe8\xb4\xff\x85\aG!\r\n\x99\xd1\xdb\xc1:j\x99h\xc2=}\x03C \xd6+T\x93\x05\xc5\xfe\x1b\xd77\u010a\x90\x13\xdb:\xb4@H5\x9c+bl)\xd5\xc7O\xaaY}2=JAe~:$\xdc1n/.^\xeb\xbe\x10%\x0e\x9c\x13\xe9\xe2\x88\"Y\xb2Hz\x8d\xaf5\x06\x17\xe9\x1c\xef3\xf2F\xa8\xa5\x1b \x8e\x0eHW=@\x1f\xce\xef\xcc\xee/\xccB\xf4Q\xad\x06\x9f50\xd5\x1c\x90\xd0sO\x10\xcc-7)x\xe3\xdaoZ\x1f\xcb\r\n/\x94\x1e\xe8\xb2Z\x80\x1c\xe9)\x15)-\r\n=yend crc32=dd91fffd\r\n.\r\n"
 
 func TestEnableCompression(t *testing.T) {
 	var cmdbuf bytes.Buffer
 	var fake faker
 	fake.Writer = &cmdbuf
 
-	conn := &Conn{conn: fake, w: fake, r: bufio.NewReader(strings.NewReader(enableCompressionServer))}
+	conn := &Conn{conn: fake, w: fake, r: bufio.NewReader(strings.NewReader("500 What?\r\n" + enableCompressionServer))}
 	
 	err := conn.EnableCompression()
 	if err != nil {
@@ -422,5 +434,181 @@ func TestEnableCompression(t *testing.T) {
 }
 
 
-var enableCompressionClient = "XFEATURE COMPRESS GZIP\r\nGROUP alt.battlestar-galactica\r\nXZVER 5000-5001\r\nOVER 5000-5001\r\nXOVER 5000-5001\r\nOVER 5000-5100\r\nXOVER 5000-5100\r\n"
+var enableCompressionClient = "CAPABILITIES\r\nXFEATURE COMPRESS GZIP\r\nGROUP alt.battlestar-galactica\r\nXZVER 5000-5001\r\nOVER 5000-5001\r\nXOVER 5000-5001\r\nOVER 5000-5100\r\nXOVER 5000-5100\r\n"
+
+func testCertificate(t *testing.T) tls.Certificate {
+	key, err := ecdsa.GenerateKey(elliptic.P256(), rand.Reader)
+	if err != nil {
+		t.Fatal(err)
+	}
+	tmpl := &x509.Certificate{
+		SerialNumber: big.NewInt(1),
+		DNSNames:     []string{"news.example.com"},
+		NotBefore:    time.Now().Add(-time.Hour),
+		NotAfter:     time.Now().Add(time.Hour),
+	}
+	der, err := x509.CreateCertificate(rand.Reader, tmpl, tmpl, &key.PublicKey, key)
+	if err != nil {
+		t.Fatal(err)
+	}
+	return tls.Certificate{Certificate: [][]byte{der}, PrivateKey: key}
+}
+
+func TestStartTLS(t *testing.T) {
+	cert := testCertificate(t)
+	client, server := net.Pipe()
+	go func() {
+		defer server.Close()
+		server.Write([]byte("200 hello\r\n"))
+		r := bufio.NewReader(server)
+		r.ReadString('\n')
+		server.Write([]byte("580 Not now\r\n"))
+		r.ReadString('\n')
+		server.Write([]byte("382 Continue with TLS negotiation\r\n"))
+
+		ts := tls.Server(server, &tls.Config{Certificates: []tls.Certificate{cert}})
+		r = bufio.NewReader(ts)
+		if line, _ := r.ReadString('\n'); line == "DATE\r\n" {
+			ts.Write([]byte("111 20100329034158\r\n"))
+		}
+	}()
+
+	conn, err := newConn(context.Background(), client)
+	if err != nil {
+		t.Fatal("greeting shouldn't error: " + err.Error())
+	}
+	conn.host = "news.example.com"
+
+	if err := conn.StartTLS(nil); ErrorCode(err) != 580 {
+		t.Fatalf("expected a 580 error, got %v", err)
+	}
+
+	roots := x509.NewCertPool()
+	leaf, _ := x509.ParseCertificate(cert.Certificate[0])
+	roots.AddCert(leaf)
+	if err := conn.StartTLS(&tls.Config{RootCAs: roots}); err != nil {
+		t.Fatal("STARTTLS shouldn't error: " + err.Error())
+	}
+	if _, ok := conn.conn.(*tls.Conn); !ok {
+		t.Fatal("connection should be using TLS after STARTTLS")
+	}
+	if _, err := conn.Date(); err != nil {
+		t.Fatal("DATE over TLS shouldn't error: " + err.Error())
+	}
+}
+
+func TestCompressDeflate(t *testing.T) {
+	client, server := net.Pipe()
+	go func() {
+		defer server.Close()
+		server.Write([]byte("200 hello\r\n"))
+		r := bufio.NewReader(server)
+		r.ReadString('\n')
+		server.Write([]byte("101 Capability list:\r\nVERSION 2\r\nCOMPRESS DEFLATE\r\n.\r\n"))
+		r.ReadString('\n')
+		server.Write([]byte("206 Compression active\r\n"))
+
+		zr := bufio.NewReader(flate.NewReader(server))
+		zw, _ := flate.NewWriter(server, flate.BestCompression)
+		if line, _ := zr.ReadString('\n'); line == "BODY <a@b.c>\r\n" {
+			zw.Write([]byte("222 0 <a@b.c>\r\nBlah, blah.\r\n..A single leading .\r\n.\r\n"))
+			zw.Flush()
+		}
+		if line, _ := zr.ReadString('\n'); line == "QUIT\r\n" {
+			zw.Write([]byte("205 Bye!\r\n"))
+			zw.Flush()
+		}
+	}()
+
+	conn, err := newConn(context.Background(), client)
+	if err != nil {
+		t.Fatal("greeting shouldn't error: " + err.Error())
+	}
+
+	var c2s bytes.Buffer
+	conn.Trace(&c2s, nil)
+	if err := conn.EnableCompression(); err != nil {
+		t.Fatal("EnableCompression shouldn't error: " + err.Error())
+	}
+	if conn.zw == nil {
+		t.Fatal("EnableCompression should prefer COMPRESS DEFLATE")
+	}
+
+	r, err := conn.Body("<a@b.c>")
+	if err != nil {
+		t.Fatal("BODY shouldn't error: " + err.Error())
+	}
+	if body, err := ioutil.ReadAll(r); err != nil || string(body) != "Blah, blah.\n.A single leading .\n" {
+		t.Fatalf("body read incorrectly: %q, %v", body, err)
+	}
+	if err := conn.Quit(); err != nil {
+		t.Fatal("Quit shouldn't error: " + err.Error())
+	}
+
+	expected := "CAPABILITIES\r\nCOMPRESS DEFLATE\r\nBODY <a@b.c>\r\nQUIT\r\n"
+	if c2s.String() != expected {
+		t.Fatalf("traced %q, expected %q", c2s.String(), expected)
+	}
+}
+
+func TestBodyClose(t *testing.T) {
+	var cmdbuf bytes.Buffer
+	var fake faker
+	fake.Writer = &cmdbuf
+
+	server := "222 1 <a@b.c>\r\n" +
+		"body\r\n" +
+		".\r\n" +
+		"223 1 <a@b.c>\r\n" +
+		"220 1 <a@b.c>\r\n" +
+		"Subject: test\r\n" +
+		"\r\n" +
+		"body\r\n" +
+		".\r\n" +
+		"223 1 <a@b.c>\r\n" +
+		"222 1 <a@b.c>\r\n" +
+		strings.Repeat("a long line of the body\r\n", 100) +
+		".\r\n"
+	conn := &Conn{conn: fake, w: fake, r: bufio.NewReader(strings.NewReader(server))}
+
+	// A reader left unread is stale once the next command is sent.
+	body, err := conn.Body("<a@b.c>")
+	if err != nil {
+		t.Fatal(err)
+	}
+	if _, _, err := conn.Stat("<a@b.c>"); err != nil {
+		t.Fatal("Stat shouldn't error: " + err.Error())
+	}
+	if _, err := body.Read(make([]byte, 10)); err != ErrStaleReader {
+		t.Fatalf("expected ErrStaleReader, got %v", err)
+	}
+
+	// Closing an article skips the rest of it.
+	a, err := conn.Article("<a@b.c>")
+	if err != nil {
+		t.Fatal(err)
+	}
+	if err := a.Close(); err != nil {
+		t.Fatal(err)
+	}
+	if _, err := a.Body.Read(make([]byte, 10)); err != ErrStaleReader {
+		t.Fatalf("expected ErrStaleReader after Close, got %v", err)
+	}
+	if _, _, err := conn.Stat("<a@b.c>"); err != nil {
+		t.Fatal("Stat shouldn't error: " + err.Error())
+	}
+
+	// Past the drain limit, Close gives up on the connection.
+	conn.SetDrainLimit(100)
+	if body, err = conn.Body("<a@b.c>"); err != nil {
+		t.Fatal(err)
+	}
+	if err := body.Close(); err != nil {
+		t.Fatal(err)
+	}
+	if !conn.close {
+		t.Fatal("expected the connection to be closed rather than drained")
+	}
+}
+
 var enableCompressionServer = "290 feature enabled\r\n211 214275 4294 218568 alt.battlestar-galactica\r\n440 admin not allowed\r\n500 command unimplemented\r\n224 xover information follows [COMPRESS=GZIP]\r\nx\x01\x03\x00\x00\x00\x00\x01.\r\n500 command unimplemented\r\n224 xover information follows [COMPRESS=GZIP]\r\nx\x01\xdd\\ms\x1a\u01da\xfd<[\xb5\xff\xa1o\x8a\xd4u\xaa\x96\xd9~\x9d\x9e\xa1\xa8\\\x10\x12\xb2\x93\xd8\u0275\xe48\u07ad\xad\xd4\x00\r\x8c\x043xf\x90\xac\xfc\xf0\xfbyO\xf7 \f\x02!!\xb8{S\xab\xb2U\x92y\ts\xe6y9\xcfyNGQ\u02bd\xf7\xa6A\xfe\xfb\xdd\xd9\u01cb\xff!urj.\xe2\xb4\xcc\u0207Y\xcf\xc4%i\xf7\xb2yI\xce\xe3I\xdc/\x93~\xec\x8d\xcd\xddm\x96\r\x86\x93\xecn\u043a1y\xf2G\x96\xfa\xa9)\xc9+\xf3\x9d\xf76K\xff\x830F\xda\xf3\x11\xe1\x94\n\xc2E\x83G\r\xc1\xc8\xf9\xdbK\xafy\xd3\xfd\xf5\xbfb\x9fiF\xf1\xa7\xf6:\x93>\xe3\x9c1.T+5\xb7\x85o\xe2\xe2\xce\xfd\xd0\u03e6\xdf{\xcd/\xdds\xfb\xf4@K%t\xf5t\x16F\x12\u007f\xb7<\x9d4\x03\x1e\xd5EWh\u074d:u&\xc2VQf\xb9\x19\xce'\x93:gJ\xf9\xb3yo\x92\xf4\xfdI|[\xe0#\u07da^yc?\xf8\xf7\x1e\xa3\"\xf0\xa4\xf7\x1b\x9e\xdc IZ\x9a<e~\x9a\x963?\x9e\x17\xcc\x1f%\xa3\xf8\xfeS\x91xR\xfa\xbd\xb8,'\xa6(\xe3\xbc>\xba\u01e5\xa1\x00\xe4\xbf\xff\x1b\xbe\v\ag\x91\x9a\xf8\x9a\xccrs\x93\x98\xdb\xff,s3\xcd\xf2\xe2/\xfb\xa3'xC\xf1\n\xbd\xb37\x16=\u0384\x94\x8a\u05a6m\xe9\xf3H\xb3Pn\x01\xc3k\xce3\x81'\v\xa9\xb9\xaa]\xfd\xc4}.5c4l\xcd\xf3\"\xae\xa7=J\v\xea\xa7=\\>\xbe\xfb\xfd\xf8{\u04bc\xfapi\xdf^\bQ\xfb\xe9\x0f\xe1sU\xa1L\x99\x9fg}?\xbd\x03NZQ\x8f\xab\u00c1\x12\x0e(\xb9\v\xa8o~\x19\xc7y\xf6\ri\xce\xc6y\xf6\x87I_M\xef~\x9f!.\x8b\xef~\x9f\xe5\xd9ll\xca\xd68+\xa7q2\xf1]\xb0l\x8b<\xa5\x97\xd8I\xf9\xd1]\x9c\x94\xb5\xb3\x10aW\x05\xdc\u02b5\xfd3\x00#\xcd}n\x19\v5\xf7\xb88\x1c]\xe9\xd0\rv\xa1\xbb3\x89/\xe7\x06I\u033f&1e\r&\x1aRVa\xd8\xef\xfdV\x85\xa1\xd62\xbc\x0fC\xa5\xd8\xffU\x18\xee\x85*i>y\xe7\x19W\xc2cGH\xff\xc0\xe1\xae\x1d\xeemr\x99\xcf\ry\x93\x16\xc9\xc0\xe4\xe4b\x86j\x80\xf4\xff\xe6\x97dfrD\xf5\xd4\xe49\x02\xda\xfe\u05ba\x8b\xc7Y\xf6X\x14\xd3\x06C\x14KR\xa7(-^\x93\x16\x16|\x1d\xd4\xd8P\xfa\x92EzQ9\xe7\xc5-\nRU\u039a\xfa\xa2\x8d'\t\u0163\xb0\xca}\x15\xa8P\x88\x1d\xb9\xcf?\x9d\xb8\xf4\xa0\xb5\xd3\xd7\xc2\x0fBA7\u07d74\x87a\xac\xb5\xa6\xb1O\x05\r\x19c\x82\x06xn\x8fJ\xad\x83\xd6,+\xca$\x1d\xf9\xa3,\x1bMLu9\x9c\xeb\xc8\x13\xf4\xf0\x90\xd6\x0e\xdapWH\xbf\b\u06a8\xc1\xc5=\xb4\xac\x82V\xdfC+u\x15\xd1k\xd0\xfeS\xcaj\xa4\xd4Q\xcajhQbtW\x00\xf6\xb2\u047c(\xe7Ek\xd9\xfe\u022b_\xb3d@\xce\U00098f0d\xd3m-\x9c\xaf\x86 yuvq\xf9\x9d\xd7\xc4\xcd\xe7\u0536\xdbPu\x02^\x17J\xac\xb7\xdb\xe0\xf1v\xfb\xac f\x88w\xef\xf0b\u0228\u00c4\xed\xc2d\xff\xc8\x11\r\x15\xdaz\xb8H\xcab\xfe;\xb2\a,\u0085\x8e\xa2`+[B\xe7\x19\xa9K\x0e\x81\x95\t\x1ex\xec\b\x901\aY\x95l\x97\xd9 \xbe\xfbkAN.\u0389\x99%E60\x05\xc9RR\xf4\x93a\xe2}s\x99\xc4\xe4\xc7y\x1e\xa7(i\xbd$\x1f$\x06\xac/Z\xef\xcc\x1b\xed\x84\x05\r\x114\xf8\x82\xd5Do\xc2\xdfc?\f\x83H\xd7\xfa]\x9f\xeb\x90\x05\xad\xfc\xb6\x9f\xa7E_\t\xb0\xc01-\x86W7\xc5h*oL\x9a\x82zq\u065b\x15\xc9|6.>\x8fF\x11\xbd\xa6-\x19\u007fq\x05g\xc9\x01CF\x83v\x9d+\xba\x1e\x94\xbb8\xa0\x8a\xd0\x05\xd8\xc1\xa5\x8aUI\x18\xb9\x80{\x02\xbdr\x9cM\xe3\x02\u0425Y1\x8b\xa7-\xcbfzYu)\xde&l\xb4!UC1D\x9d\xb6\xad\xa07\xeeE*\xa6\x83\\\xb4\x8c\xa5\xa7\xa0\xac\xf8>\x9a\xdfU\xb5w?\xdc\xfaq\x9a\xcd\xe6E=\xec\xea6\xedXZ\x1eQ\xc58\r-\x8bw\xc1\\/L\x0e\xba\xef\xf7\x92\xd1,K\a\xb6\u0540\x1e\x83\x14\x06\xa1\xf6\xb8>\x1c\xb8\u0206\x1d\xdfY\xbdJ3h-\x19\xb7\xfdd\xf6S\x90W'K>\xfeuN\xa9\u018f\x8f\xa6\a\x84A\xe8\xbf\xf3V\t\rc\r\xae\x1a\x92\xdfC\xb9\xde\xd88\xa3\\\xf9*\f\xe4@\x18\xb3\xb5\xb1=\xa7\x84\x1d\x92\u0324\xf9\xac\x9a\u00b4\rZ~0\xf6\xdcUI^U\xc9'\x82\xf6\x9d\x99\xe7\x19:\x05f(D\xee \x1b\x19t\xfcY\xd1\x02\v\xef\x03\ua282l\x04\xaf\x9d\x03C\x80^Q\xc8\xf2G\x83\x9cG\v\x01\xe5\xad}z\xa7\xfcP+\x8d\x06\xd2wYO\u067eY\xff\xe2\xe8E\xd5\u069dE,d\x91\xc7\xc2\xc3\x11vE\x95W\xa3\xf6#\xe4p\x94\x87\xfdx0\x8d\xd3V\x9c\xb9\xa9\xc6&\xb2\xfdK^\x9d\xbf\x0fI\xa7}\xfa\xb6\xfd\x0e\x81,V\x989m\x88\x10\u4f02\xd5f\x04\x88\x19\xe7T\x84L\xf8\x94K\xc5}\x8a/\xb4\x06\u065a\xf6\xeaS\xce\xfc\xc5{\x03\u2f63\x9e\xf1\x90\x1e\x05\f7(\xf3j\xfe{\x04\x8c_\x93t\x90'k<\xe5\xe2\x8e\xfc\u44df\xffz\x91\xf4\x12\x10\x95\xcd\x18c\r;\xf1\xa9\xaa-/\x89\n\x0fx`\x89J\u050e\x84\xa8\x8b\a-A\xee\xe0)\xfb\xe1\xc9x\x10y\x87\x0f\xc7\u070do\x98\xb2\xf7\x11e\xa0B\xa4\x05\x14\x9ak\xe81i\xff\xebDA^\xb5\xf1\xc8z\xf1\xe3P\x14\x04(\xdd}\xf1C\xea\u045e\n\xfa\x15\xab\x87\x1e\xa3\x84/\a\x81\xa6j@\xb7\x17\xbf\xfe@\xcb\xe4\x86\x0e\a7\xdc\xf4\xf9\xe7t\xfey<\x8d\x8d\xd6*)\xe3\xd9@\xf3\xd1x\xa5#W R\u01a4\b8\x822\nU\u8092\x86\x11wA\u027e\x06%i\xaaI\xf7#\x06\x1c\x06\x15\xe2\xfcR\xfbx\xf5B\x86\xe0+2\x04\xa6\n\xa4\xe5\xa1z\rW\xae\xe3T\x83\xf2s\xe5\xaf}\x91Fv.;\xf6&\u04a1/\xf4@GT\xeb\xedH?\x8d\x06(\x8f\xe2\xe0\xdf]~\xc2\u06ddn=@\xa6\xaf\xe9^\xfc\xd1\x00'M}\xfb\x01`3\x19Q^\xfb\xd6pT\xe4{9\x89\xae\xc0\xad0R\x1ca>\xe6n>\x06S\xd8'\xb0\xf7\x95\x81>\x9a\x01\xb4\x8b\x95\n\xc9@\x99dC.\x04H\xd5+m\xe3\xd1J\xb2Z\u0260\x03A-\xa4\x8e\u07ac\\p\xf3_\x1b\u07f8-\xfbf$\x8b\x02HGG\xe0\x01n\xce\xe6\x15\xf5\u007fnF<=Rn\xde\x14\u07a0\x01\xaa\xd0z\xa5\x96\xd2\x05\xb2hk\xcaO7\n\xf5\xe3q\xec5\x9fu[\xd1\x04\xa3#\xa8\xbc\xdc\x11|Qq\xa5\xb3\xa2\x9f\xe5%\xb9\x18'\xb3\x02\xdan<\xb9\xfbc\u070a\xf3\xb8o\xd91:7\xf2f\xa5YK;H\xe3_\x9c\x16\xde\x1b\x0f\xf9(\xa6W\xc1\xa8\xa2\xef\xf2\x01}\x0f\xa4R\xb6m\x89\x88\x9d\x8a\xba\x14l=\xad\xf5\x8e\xb4^\x94\u0708S\xa5d\x88\x91JG\xba\xe2\x01\x90\xd2+\x1e \xbe\xf2\x00(\xc3\xca\x13\x877.\xe1(\x8e\xac\b\xfc\x1a0OG\xc8\xe5x\x8e\xb4]\u014a68\xe6\x9d\u0148}\xdf\xcby\bncA9a\x1d\u07a9s\xfe@\xe3\xdf\xd5\u031f\xc0\x9bs\x15\x1eC\x84\x91\x8eJ\xcb-\xe1\xf1\x12\x14\xac>\xb5T\xff\xeeQ`2\x94\xa1C!:\u0458r\x1fV\xfc\xf0\xd1\u0430C\xe3\u03a8\xc3{A\x8a:|\x98\x93U,h\xef\xa3\xc9\r)!7\x93\x1c\xd91\xb9#EFzq\x1aO\xfe\xe6=\x8dG7O\x10\x15j%\x83@w!\x1eX\xea\x025\xf4\x1e\x0f\xd4\r\xf0\xba\xae\xe8h\x16\xa8z\xf0 &v\xd4\rp\xd9Hb\xabq0\x93\x90\xaen\u02aan\xee\xb8\xe4\u02f1!o\xad\xf2K\x9c\x0e\xfc\xa8\f\xbcy\xe1\xe8b\x98\xa0tU:\u00b8\u032en\xae'j\x18\xf0\xcf\xd1\xf5\x84\x0e\xb2\x99\x88B\xc3\xe2\xf2&\xe9]G\xc3\xfe\x92\u007fy\u043f_\x88\x0e\x93\xb8\xa0#\xf4}\xe9\n\xa6\xaa2\xe2\"\x9b\x1a\x92\r\xc9]6'\x90*\n\x12#>b\x17\x1c\xa47O\xfbc\xfb\xe0$\x83\xd6Px\xa39$m\x8bQk\xba\u04137pA=\xa5Q\x83.\xba;b\xe0\x94\x89v\xd7?\u9788\xf0\xac\x13._\xd8\xec\x99ThU\v\u02b4\xe45\xd6zsZ\a\xd3\x01)uE\u05df\xa7I\xbdg\xf2I\x92\xfa\x03c%\r!<\x88i\x87\x12L\xe5\xb2@UC\xdf\U0006f721@.D4w\xe5\x123_\x14\xc9\xe0,<k\xbf\xf4\xca\xc1\xfa\x8fr\xe5n\xc2S\u05447\xcc\x13\x93\x0el\u007f\u07319\u07bc\xb5\x969/UB\x93F\xdf\xda\xe5\xa4\x0e\xa1\u04f9\xa5\x13\xba9\x97\u06d7N\x0e\r\xa5N}\xd5\xc6\xd3e\x17=o\x11@Pv\xa0\x91\x1e\x9e\xf0\xca\ri\x90\xed\xeb\xf7_\u07d3an\f\x99\x8d\xb3\u0510AlW!\u0625\xf7Q\xf3H\x9c\x0e\xc8\xd4@\xa8*\x10\xfc\xf6\xe14\xbb\xfd\x8b\xfd\"\x04;?\xed\x95\xd77\xb3\xab\x1c\xa2i\x9e\xde\x15\xc6\xc9\u007f\xde\x06\x1c\xe8\x03\xd4\xca\xceU\x05\xb8\xeb\x0e,\x1c*\u0435\u03b7\xd8\xd5b\xf4|\xc8b!f\x86\x91w\x84 V\xd5\u03bb\xd7o\xf0\x00\x99\xe6\x16\u0f7e\x8f\xa2\xfe;XN\x83\x05U\x94W\xbc\xfe}\xd2\xc7\xdev@^\u01e5\xcd\xe5\x94t\xcc\xc4\xf4\xf2\xa4\xbc#\xa7qi\x8a\xe7I\xc6\x17q\x89\xc2\x1f\xac\x14~\xd6\xc0\"\\\xd0\xea\xea\u007f\xfe\xfb\xd0]\xbd\x94A\x8d\u007f\xb13\xa2X*\xc6\u0707\x18\xe5\xc7\x05v\xf7\xe5\xfd>\xac\xe2CLq\x8c\xe8\x02\xabp\x1a\xb1J\x17\xa1T\xe9j\x04]\xe1C\xc8\xfe\xe8\x18{X\xe5\x9a\x02X\x85\x9dw\x9e\u01a5cf\xe3\xb9\x15\x83\xafz\x056\xf5\xb2\xd53\x93I\x01\xef\u0178\x92\xd46#\x82\x81\x1f\x81GW\xcd\xd0k~~=\x02*\x81\f\xa2\xdaPb9(\xa0\xa3\x0f\r\x95\x00b\u00ad\xdda^@'v\xb4\x14\xe2\xcf^\x98\x90\u6790s\n\x12\x01\x8e|p\x05\xadzG\xa5\xa6?\x89\xe14\xee%\xf3B@\xf0\xbaW\xd0\u022b\xb7\xcb\u007f\x83\n\xb2\x1aQPAdCDUD-\xd1\xe0J\x8a\b\xf9D\x03H\x15\xf6\vB\x9a\x8b\x10\xaa\xbf2\xe6\xfd\xa0\x8e U\x1c. *'\x8f\a\x15\xbb\xbe\xaf\xa8\xe7&\xcbG\x86\xb4\a\xf1\xb4\xb8NHs\xe4~\x8f\xab_[\xf3a\x86\x8d\u027c\xa8\xf6\x01\x9b9%\xacv(V:H[\v\xe9\x9f\x06g\x1d\xddU\xc1\x83\x977\x1f-\xaa\xa4\xb9Oqf*d\xc7X\t\a\x8eg\a\x15\xabx22\x0e\x05Jr\xbb(vs\x9b\u0161\x1d\xb2\x8e\u007f\n}\xf5\xec\x8cn\x00\xb5\x8c\xa5\xe7U\x9b0B\xb59<S\xaa*\x1cT\xe6\xa3'\xf1x\x01\xf7\xb6\xb2\xa1\xb2\xd3\xeb:\xf7\x86~b\xb9\xf7i\xfb\x04\xc3;G\xd9Z\x13\x9fvm\x81\x978=+\xe7\xa0\x1f\xaa#\xa8\xab\x81\xb3\x1e\x05{\x10\x93\u037cA\u0660Kc\u0477yb{\x91\xa4B\xa9\xb0\xd6\u007f\xc3}h\xed!\xccg\xce1\xf6\xc0\xd0v\x9c\x1c\".\x17w%\xabuz\x1d\x83\xba\a\x8e\xe6\x04\xcfm\xed{Z\xb66\x90\x85V\u01e0\f-*R\xf0>\xb7\u0206\x01\xad\xbdI\xe0uS\xacb{\xab\xa6\xadq\u01e2\xafj\xa7w\xc0\xbdb@K\xbb\x1a\xf6o\x1a\x14\xd1EgG\x8b.\xa2\x93\xafG\xe7\xaeu0J\xe114\xe6\xc0\x91\x80\xe0\x99$\xe0\xe9\xb4\xdc\u010ca\xfci0\xba\x9e\x96\f\xd6 7\x13\x9fA\x01\n\xea\xd1\xfau\xef\x92Iv!\xea1%\xa1\xbc\x1f\xae3\x06\xae\xadc]b\xa9\u044b\x9a\xd9\xdc\xfaL\xf5\nAt\xf4\xf8>t\x90gg]\x1a0\x9f\agmJ1&\xac\xf7\xc2#5\xb3'\x13\x914\xf7)\x0fX\xbc\xa0\x15\x1c\xee\x96\n\x1cU\xd0\xcfl\x8d\xfb*\xec\x17[\xa0g\xe0\xa1\vn\xfei\u05b1Y\x1b\xf1\xa8\xf6\xa1\xab})\x1f\xa6%\f\x1d\xbb\x93\xf6\xa5\xa1\x8b\xb2\vfq\xb8\x8fO\xbby\x06^\xcc\xd5\xe0\xdc9\xbbnB\"\xe1\u04f0\x9bH\xc7\x18~z\u007fj!A\x87\xe0LV6f\t\x9a\x0e\u007f\u017f\xb2I\xec\x15\x9b.\xd2w\xa6T\xa40X\x1f\xcc\xf7\xb5\xd3\rt\xc5b\xb6\xda:\x92\x14r\xd1\x05\xac\xd70X\x9ak\xf2\x0e\x14w\xe2}s\U0004f51cg\xc6:\x12\x8a\u007f\xa4\xf0 \x9a\xb45\xca\x06\xbe\x99\xc2`\x99\xe5\xfeu<M|\x93\xc2\xeb\xbdy\xaf\x02\xdbt\xeeW\xe8\x8cu\xed\xbdbLKZ\u02cb\x1f\xe0\x84\x11J.VD\xd2\xefM2\xbc[R\u0611\f\xae\xe9\x1e\x8c\x8e9\x1c\x10\xf9\x82c7\x87#\xf5\t\xdb\xcbP\xc1\xa0=\x9f\xc3\xd7\xc0\x19j\xef\xc2\xd6 a\xb2\xcei\"\xaenF\xca\xcc\xf8\b-\xe6j\xd0\u02e32\xbd\xb9N\xb2Y\u04a3\xe1\xd5\xd5p)\u0751\xe6\u0355\xea\xb3\xeb\fz^\x01\x9b%\x1c\x16\xf9\xcc/\u6e22{7\xfa\xf7\x1e$\x10\xd8\xe4\x0e\x9f\xf2\xb5\xe3EZzo\x17B\x85\xd5)\xf2d4.\x9dZ\x01)cE\u0780Hq/qXSP\xd27\x84D\x1c\x19\xf3\xf9\xba\x18\r\x92\x96\x13A\ue54c\r\xc0\x99=\x0f\xd0\xe0\x8b5\xc8\xe8\u01ff\x03ph\x8d\x94\xd6BxO\x19\x8f\xa0\xe0\xda\v\\\xd9\xc8A\u02e0\xec\bZ\x86v|\x06\xeb\x0e\x9b\u069dq\x9c\x8e\f\xa4\x99;2\x85\xd9\xc0'\xe4<\xb9\xb1\xb2\r\xfe\xe3+>\xa2\x98@\xd1H\xfb\xc6\xeb\u0190<r\xf2\v\x14\xed\xc18\x99\xc0\x99\xbe\xf8\xa9e\xe2\xbc\x1cC<\xbc\xae&\xf5\u028a\x1e\xae4'\x90\x1a\xec~\x96*%\xc8\"\xfe\xf8A\xe7D=|m3\xb8\xd5\xf0\x12\x06!T\xae\x9b\x1f\xc1z$l\xa8\x98\xe2\u0576)\x9e41\x9f\xe2\u065ak\xa9koJ\x89m\xb2uz\u073b\xe7\u0606\x16\x82m\xb1\x1eX\xaf\"6\xa0x\u0257\x91\xf2\x19\f\\a\xd8*o\x13\ub4f2'\x1e\xa0]\xf9y^M\x8d\x98\x84\xe51\xc4\x10\xedv\xed\x10\xbb^\x82\xfcse\xf3M\xe0\u04570\xb9-\x80O\xe6W\xf4\xfa&\x9b}\xbe\xa2\xf3 U\xd7\u0440\xa5\x9f\xc5M\x18\a\xd3\x11\xcd\u0348M\x96\xb9\xe7\xfd\xc9\ue0ed\xbf;\xa2\x06\xdc\x18>\xeb#\xb4>\xc7Vu\xe8\xdd\xc6pZ\xae\x8b\x96\xcbb\x80Z\x90\x94d\x90\xa5%\xe9\xc3\xf3MRHT\xa8\xcaur;\xbeC\xb1(\xffFH\xa8$\xf7&\xf9\xb0?\xf9\xd2\x1a\x98\xc9\xfc\x8b\x93_\x17\xa7\x83V\x13\x03\xa3\x14\xac(\x8b\xfb\xd3;\xff\x84R \x05\x85\xf7C\xdc\xf9\xb05TG[\xd6\n\x01\xc5\xc6\xe6\b\xe5\xce\x11P\xf0\x85\x97\x84\xe3\xb3\\\xaf\x1b\xb1\x88\xe1\x86\u00dd\xb4\x10p\xf9(\xa0=\x94=\xae!\xd7\xc1\xf6\xaa`\xad\xe2\xcb\u0135\xb6\xd7?W!x*\x00C`\x89{~\xa8\xde\x17:\xee\x15rok\xef\xe7\xaa\x1c\x93v\x9a&\xf0\xa1\x161\xb6h\x17}h\uea6d\xd9\b\xc0\x9f\xb2\x02\x0f\x8e\xa07Ce\xbe(\r\xb4/\xd2\xf6\xc9E2\x8d\xaf-/(\xec\x0f\x0f\xeb\xed\xe6m\xb2\xfb\u0165Q1\x1a\xc3\x1b\x0590\xaa}9\r\u041b*\"\x80U\xe6\xc0ZOqbm\x16\xdbSkk\xe5\x1f\x14\x1e\xf6\xdc#x\x06CG\x86\u0bb5A\xfa\x0eM\t\xcd6\x81\xfdzq\xa8\xcc\ub71f\xe0\\I\x8a\xf3%\xadIbO\xa4\xad\xf8\x87\u026b\x1f\xccph\u03dd\xd8\xc7!}\xaed\x1d\xe8\x8eUr\x96\xeeW=\x1c0\xd9S\xa62\x80\xe1`\a\xce\u02a9\xc8\x04<\xe6\xfd\xed\xb6\xa4\x9b\x1f\u007f\x03\xcd\t`\xd5\x0ek\xbf\x96\x01\xf62\x9c\xb2e\xf4nJ\xf0\xa4\xf9\x89\u007f\xc2+\xe04\x8a\xb0\xb2\xb8\xfc\x01\x86O\xd8ka\xbd\xd9\xdevH3J\xa9mS\x11c\xb5\xb3[\u06e4B\x15T\x93\x84\x05\x1f;\xdd\x1c\xec.)\xc1\xf0\xd6\xc1\x0f\"hi0\x96\x1e\x1e\x89\xae\x14\x86\xa1\xf7\x1a2|\xb6\xc2\a\xbap\u0691W\x1f\xe3\xdc\xc6\xddw/\xbf\tn`\xe5vnwn\xee\x877\x01\xe3\x82/)\xce\xf7\x04\xdb\x1d\xc8^\x10`\xa5\nG\xe6\xe1WZ\xd5\xc2j\x18\xdf\x12f;G\x9f\x8d\xfc\x81k\xc3\xc6\xd7bS=\xc8c\x9b?J\xe90\n*uLB(\xab\xee\xe4\xc3\xe3\x9e\u007f\u06a8\x82#k\xdf\x14\x01_=\xc6<\x14\xbaQ>\xaaf\xd1-\xf7f\x8bK\xb6\x03\xef0\xf9\x98L\\\xdao\xdc\x1e\u6daa\x1br\x11\xb5V#\xc9\xd4)=\xabK\xb9\x87\x88\xbb\xcf\r\xb6C\xd5\x11\x8e\xf4D\xae,\x82\x06<R\x16\xf7\xc7\x04\x03\t\x96\x8b\x0f\\%\x18D\x94\xf5UJ\x06;\x9a\x82\x01\xeb\x81q\xf8q\x03\x96\xd7\xfc\xa0\x8c\v{\x9cH\x06\u02f6'u\x05\xfc*\xd8\u01f9\x9e\xb1.\n\xa3\xdc\x06\xde\xe1f\xd6\u020d6Q5\xda\\\x8e\x93\x02\xdd\xc2L\v2I\xae\xad\xc1b\x84\xb2Y\x92\x19,\u0086\f\xb3\x9c\f\f\x0e1\x1b\xefEX\xe1\xec\xceC\xb9\xf1+V\xd8\\\x02\xab\aB\xeb.\xac\x1e\xa6\x16\xce7\xfb|\xc8C\x11\xc7\xd1\xd6\xee\x83sV\xea\b>\xbe\xc8M$Q\xe5\xfe\u0752Z/\xee\xaea\x83\xe2l\x89\xf56n-\xecpX\xa0\x9dI3\x84\xb1o\xeb\xf55\xff\x1f\xd5A\x1c\x10\u0663\x03\xc0\xbe\x85\xe3,\x87\x9f`\x8b\x9c\xd38z\x948\xed\xech\u0571\x82hez\x87\x92\a=o\xa1W\xfc\x9c\x0f\x91\xda\x1a\u0377\xf6\xe9\x17?Z\xb8\xd3\x1f\xb6\xb2=\n\x00\xd6\x14_\xb3g\xdfJ\x03u\nT\xf3p\xde\x1d9\xb6\x13Uk\x8agW\x8f\xfdqDb,\x98\xc1iQ\u1a23\n\xc7\xed\xff\a\x88}\x8b\xc3\x1a\x98\xfb\x96\"\x9c\x19d\xde\xe1\u07a0\xc8\xf1)lum\u007fz6\x96{Wb\xbb\x8f\xb5's\xd7\x17?\xe0\xe4\xf6\xb0\v4\xf4\xb3.\xab38!\xd6\xf6\xb1b\x875\xf4Yw\x04\xe72\xc410\x02\xaf\xf9_\xd9d\xfe\xd2.\r\n"
\ No newline at end of file
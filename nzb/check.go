@@ -0,0 +1,84 @@
+package nzb
+
+import (
+	"context"
+	"sync"
+
+	"github.com/willglynn/nntp"
+)
+
+// An Availability says how many of the segments of a file a server has.
+type Availability struct {
+	File    *File
+	Present int       // Number of segments the server has
+	Missing []Segment // Segments the server lacks
+}
+
+// Percent returns the percentage of the file's segments the server has.
+func (a *Availability) Percent() float64 {
+	if len(a.File.Segments) == 0 {
+		return 100
+	}
+	return 100 * float64(a.Present) / float64(len(a.File.Segments))
+}
+
+// Check asks each of servers, which maps names to connections, whether it
+// has the segments of the files in n, without downloading them. Each
+// server is asked with Conn.StatMany, keeping up to window commands in
+// flight, and the servers are asked in parallel.
+//
+// The result maps the name of each server to the availability of each
+// file in n. If asking a server fails, or it refuses to answer, as when it
+// wants authentication, the first such error is returned, and the servers
+// that failed are left out of the result.
+func Check(ctx context.Context, n *NZB, servers map[string]*nntp.Conn, window int) (map[string][]Availability, error) {
+	var ids []string
+	for _, f := range n.Files {
+		for _, s := range f.Segments {
+			ids = append(ids, s.ID())
+		}
+	}
+
+	var wg sync.WaitGroup
+	var mu sync.Mutex
+	var firstErr error
+	result := make(map[string][]Availability)
+	for name, c := range servers {
+		wg.Add(1)
+		go func(name string, c *nntp.Conn) {
+			defer wg.Done()
+			stats, err := c.StatManyContext(ctx, ids, window)
+
+			mu.Lock()
+			defer mu.Unlock()
+			if err != nil {
+				if firstErr == nil {
+					firstErr = err
+				}
+				return
+			}
+			result[name] = availability(n, stats)
+		}(name, c)
+	}
+	wg.Wait()
+	return result, firstErr
+}
+
+// availability tallies the results of STAT for the segments of n, in order.
+func availability(n *NZB, stats []nntp.StatResult) []Availability {
+	avail := make([]Availability, len(n.Files))
+	i := 0
+	for j := range n.Files {
+		a := &avail[j]
+		a.File = &n.Files[j]
+		for _, s := range a.File.Segments {
+			if stats[i].Err == nil {
+				a.Present++
+			} else {
+				a.Missing = append(a.Missing, s)
+			}
+			i++
+		}
+	}
+	return avail
+}
@@ -0,0 +1,58 @@
+package nzb
+
+import (
+	"context"
+	"errors"
+	"testing"
+
+	"github.com/willglynn/nntp"
+)
+
+func TestCheck(t *testing.T) {
+	full := bodyServer(t, map[string]string{"<a1@x>": "", "<a2@x>": "", "<b1@x>": ""})
+	partial := bodyServer(t, map[string]string{"<a2@x>": ""})
+
+	servers := make(map[string]*nntp.Conn)
+	for name, addr := range map[string]string{"full": full, "partial": partial} {
+		c, err := nntp.Dial("tcp", addr)
+		if err != nil {
+			t.Fatal(err)
+		}
+		defer c.Quit()
+		servers[name] = c
+	}
+
+	n := &NZB{Files: []File{
+		{Subject: "a", Segments: []Segment{{Number: 1, MessageID: "a1@x"}, {Number: 2, MessageID: "a2@x"}}},
+		{Subject: "b", Segments: []Segment{{Number: 1, MessageID: "b1@x"}}},
+	}}
+	avail, err := Check(context.Background(), n, servers, 2)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	for _, a := range avail["full"] {
+		if a.Percent() != 100 || len(a.Missing) != 0 {
+			t.Fatalf("expected the full server to have %q, got %+v", a.File.Subject, a)
+		}
+	}
+	p := avail["partial"]
+	if p[0].Percent() != 50 || len(p[0].Missing) != 1 || p[0].Missing[0].Number != 1 {
+		t.Fatalf("unexpected availability %+v", p[0])
+	}
+	if p[1].Percent() != 0 || p[1].Present != 0 {
+		t.Fatalf("unexpected availability %+v", p[1])
+	}
+
+	// A server that won't say isn't a server that lacks the articles.
+	locked := bodyServer(t, map[string]string{"<a1@x>": "!480 Authentication required", "<a2@x>": ""})
+	c, err := nntp.Dial("tcp", locked)
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer c.Quit()
+	avail, err = Check(context.Background(), n, map[string]*nntp.Conn{"locked": c}, 2)
+	if !errors.Is(err, nntp.ErrAuthRequired) || avail["locked"] != nil {
+		t.Fatalf("expected ErrAuthRequired and no availability, got %+v, %v", avail, err)
+	}
+}
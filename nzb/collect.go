@@ -0,0 +1,177 @@
+package nzb
+
+import (
+	"regexp"
+	"sort"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/willglynn/nntp"
+)
+
+// A Collection is a set of files that were posted together, such as the
+// volumes of a RAR archive with their PAR2 files and an NFO.
+type Collection struct {
+	Name     string    // The name the files share, e.g. "name" for "name.part01.rar"
+	Poster   string    // From header of the articles
+	Date     time.Time // When the first article was posted
+	Files    []File    // Files, ordered by subject
+	Complete bool      // Whether all the articles of all the files are present
+}
+
+// collectionGap is how long after a collection's last article an article
+// with the same subject pattern and poster is taken to start a new
+// collection, rather than continue the last.
+const collectionGap = 24 * time.Hour
+
+var (
+	// segmentCounter matches a "(03/50)"; the last in a subject counts
+	// the articles of a file.
+	segmentCounter = regexp.MustCompile(`\((\d+)/(\d+)\)`)
+	// fileCounter matches a "[1/12]" or "(1/12)" counting files.
+	fileCounter = regexp.MustCompile(`([\[(])\d+/(\d+)([\])])`)
+	// quotedName matches a file name in quotes.
+	quotedName = regexp.MustCompile(`"([^"]+)"`)
+	// volumeExt matches the extensions that differ between the files of a
+	// collection.
+	volumeExt = regexp.MustCompile(`(?i)(\.(part\d+|vol\d+[+-]\d+|r\d+|rar|par2|nfo|sfv|nzb|zip|7z|\d{3}))+$`)
+)
+
+// parseSubject splits the subject of an article of a binary post into the
+// subject of its file, the pattern shared by the subjects of its
+// collection, the collection's name, and the article's place in the file.
+// ok is false if the subject doesn't look like a binary post.
+func parseSubject(subject string) (file, pattern, name string, number, total int, ok bool) {
+	locs := segmentCounter.FindAllStringSubmatchIndex(subject, -1)
+	if locs == nil {
+		return
+	}
+	loc := locs[len(locs)-1]
+	number, _ = strconv.Atoi(subject[loc[2]:loc[3]])
+	total, _ = strconv.Atoi(subject[loc[4]:loc[5]])
+	if number < 1 || total < 1 || number > total {
+		return
+	}
+	file = strings.TrimSpace(subject[:loc[0]] + subject[loc[1]:])
+
+	// The file name is quoted, or else it is the last word with a dot in it.
+	fileName := ""
+	if m := quotedName.FindStringSubmatch(file); m != nil {
+		fileName = m[1]
+	} else {
+		ws := strings.Fields(file)
+		for i := len(ws) - 1; i >= 0 && fileName == ""; i-- {
+			if strings.Contains(ws[i], ".") {
+				fileName = ws[i]
+			}
+		}
+	}
+
+	pattern = fileCounter.ReplaceAllString(file, "$1#/$2$3")
+	if fileName != "" {
+		name = volumeExt.ReplaceAllString(fileName, "")
+		pattern = strings.Replace(pattern, fileName, name, 1)
+	} else {
+		name = pattern
+	}
+	return file, pattern, name, number, total, true
+}
+
+// Collect gathers the articles of binary posts among overviews, which are
+// from the group named group, into collections of files. Articles are taken
+// to belong to the same file if their subjects differ only in the last
+// "(03/50)" part count, and to the same collection if, in addition, their
+// poster is the same, the file names differ only in extensions such as
+// ".part01.rar" or ".vol00+01.par2", and they were posted within a day of
+// each other. Articles whose subjects have no part count are ignored.
+//
+// Collections are returned in the order they were posted.
+func Collect(group string, overviews []nntp.MessageOverview) []*Collection {
+	type collectionKey struct{ pattern, poster string }
+
+	// Taking the articles in the order they were posted lets reposts
+	// start new collections.
+	ovs := append([]nntp.MessageOverview(nil), overviews...)
+	sort.SliceStable(ovs, func(i, j int) bool { return ovs[i].Date.Before(ovs[j].Date) })
+
+	var collections []*Collection
+	latest := make(map[collectionKey]*Collection)
+	lastDate := make(map[*Collection]time.Time)
+	files := make(map[*Collection]map[string]*fileParts)
+	for _, ov := range ovs {
+		subject, pattern, name, number, total, ok := parseSubject(ov.Subject)
+		if !ok {
+			continue
+		}
+
+		ck := collectionKey{pattern, ov.From}
+		c := latest[ck]
+		if c == nil || ov.Date.Sub(lastDate[c]) > collectionGap {
+			c = &Collection{Name: name, Poster: ov.From, Date: ov.Date}
+			collections = append(collections, c)
+			latest[ck] = c
+			files[c] = make(map[string]*fileParts)
+		}
+		lastDate[c] = ov.Date
+
+		f := files[c][subject]
+		if f == nil {
+			f = &fileParts{total: total, numbers: make(map[int]bool)}
+			f.File = File{Poster: ov.From, Date: ov.Date.Unix(), Groups: []string{group}}
+			files[c][subject] = f
+		}
+		f.add(ov, number)
+	}
+
+	for _, c := range collections {
+		c.Complete = true
+		for _, f := range files[c] {
+			sort.Slice(f.Segments, func(i, j int) bool { return f.Segments[i].Number < f.Segments[j].Number })
+			c.Files = append(c.Files, f.File)
+			c.Complete = c.Complete && len(f.Segments) == f.total
+		}
+		sort.Slice(c.Files, func(i, j int) bool { return c.Files[i].Subject < c.Files[j].Subject })
+
+		// If the subjects count files, all of them must be present too.
+		if m := fileCounter.FindStringSubmatch(c.Files[0].Subject); m != nil {
+			if total, _ := strconv.Atoi(m[2]); len(c.Files) < total {
+				c.Complete = false
+			}
+		}
+	}
+	return collections
+}
+
+// fileParts is a file being put together from overviews.
+type fileParts struct {
+	File
+	total   int
+	first   int // number of the segment the subject is taken from
+	numbers map[int]bool
+}
+
+func (f *fileParts) add(ov nntp.MessageOverview, number int) {
+	if f.numbers[number] {
+		// a repost of a segment we have
+		return
+	}
+	f.numbers[number] = true
+	f.Segments = append(f.Segments, Segment{
+		Bytes:     int64(ov.Bytes),
+		Number:    number,
+		MessageID: strings.Trim(ov.MessageId, "<>"),
+	})
+	if f.Subject == "" || number < f.first {
+		f.Subject, f.first = ov.Subject, number
+	}
+}
+
+// NZB returns an NZB for the files of the collection, with its name as
+// the title.
+func (c *Collection) NZB() *NZB {
+	return &NZB{
+		Meta:  []Meta{{Type: "title", Value: c.Name}},
+		Files: append([]File(nil), c.Files...),
+	}
+}
@@ -0,0 +1,75 @@
+package nzb
+
+import (
+	"testing"
+	"time"
+
+	"github.com/willglynn/nntp"
+)
+
+func TestParseSubject(t *testing.T) {
+	tests := []struct {
+		subject, file, pattern, name string
+		number, total                int
+	}{
+		{"name.part01.rar (03/50)", "name.part01.rar", "name", "name", 3, 50},
+		{`[1/12] - "My Files.vol00+01.par2" yEnc (1/2)`, `[1/12] - "My Files.vol00+01.par2" yEnc`, `[#/12] - "My Files" yEnc`, "My Files", 1, 2},
+		{"Some (1/2) thing.r01 (2/2) posted", "Some (1/2) thing.r01  posted", "Some (#/2) thing  posted", "thing", 2, 2},
+	}
+	for _, test := range tests {
+		file, pattern, name, number, total, ok := parseSubject(test.subject)
+		if !ok || file != test.file || pattern != test.pattern || name != test.name || number != test.number || total != test.total {
+			t.Errorf("parseSubject(%q) = %q, %q, %q, %d, %d, %v", test.subject, file, pattern, name, number, total, ok)
+		}
+	}
+
+	for _, subject := range []string{"Re: a discussion", "counted wrong (3/2)"} {
+		if _, _, _, _, _, ok := parseSubject(subject); ok {
+			t.Errorf("expected %q not to parse as a binary post", subject)
+		}
+	}
+}
+
+func TestCollect(t *testing.T) {
+	day := time.Date(2020, 1, 1, 0, 0, 0, 0, time.UTC)
+	ov := func(n int64, subject, from string, date time.Time) nntp.MessageOverview {
+		return nntp.MessageOverview{MessageNumber: n, Subject: subject, From: from, Date: date, MessageId: "<" + subject + "@x>", Bytes: 100}
+	}
+	overviews := []nntp.MessageOverview{
+		ov(1, `[1/2] - "set.part1.rar" yEnc (2/2)`, "joe", day.Add(time.Minute)),
+		ov(2, `[1/2] - "set.part1.rar" yEnc (1/2)`, "joe", day),
+		ov(3, `[2/2] - "set.part2.rar" yEnc (1/1)`, "joe", day.Add(2*time.Minute)),
+		ov(4, `[1/2] - "set.part1.rar" yEnc (1/2)`, "joe", day.Add(3*time.Minute)), // a repost
+		ov(5, `Re: set`, "ann", day),
+		ov(6, `other.mkv (1/3)`, "ann", day),
+		ov(7, `other.mkv (3/3)`, "ann", day),
+		ov(8, `other.mkv (1/3)`, "ann", day.Add(72*time.Hour)),
+	}
+
+	cs := Collect("alt.binaries.test", overviews)
+	if len(cs) != 3 {
+		t.Fatalf("expected 3 collections, got %d: %+v", len(cs), cs)
+	}
+
+	set := cs[0]
+	if set.Name != "set" || set.Poster != "joe" || !set.Complete || len(set.Files) != 2 {
+		t.Fatalf("unexpected collection %+v", set)
+	}
+	f := set.Files[0]
+	if f.Subject != `[1/2] - "set.part1.rar" yEnc (1/2)` || f.Date != day.Unix() || len(f.Segments) != 2 ||
+		f.Segments[0].MessageID != `[1/2] - "set.part1.rar" yEnc (1/2)@x` || f.Segments[1].Number != 2 || f.Groups[0] != "alt.binaries.test" {
+		t.Fatalf("unexpected file %+v", f)
+	}
+
+	if other := cs[1]; other.Name != "other.mkv" || other.Complete || len(other.Files[0].Segments) != 2 {
+		t.Fatalf("unexpected collection %+v", other)
+	}
+	if repost := cs[2]; repost.Name != "other.mkv" || !repost.Date.Equal(day.Add(72*time.Hour)) {
+		t.Fatalf("expected a late repost to start a new collection, got %+v", repost)
+	}
+
+	n := set.NZB()
+	if n.Get("title") != "set" || len(n.Files) != 2 {
+		t.Fatalf("unexpected NZB %+v", n)
+	}
+}
@@ -0,0 +1,250 @@
+package nzb
+
+import (
+	"context"
+	"errors"
+	"io"
+	"io/ioutil"
+	"sort"
+	"sync"
+
+	"github.com/willglynn/nntp"
+)
+
+// A Report says how the download of one file of an NZB went.
+type Report struct {
+	File *File
+	Name string // File name from the yEnc headers, if any segment was decoded
+	Size int64  // File size from the yEnc headers
+
+	Done    int       // Number of segments decoded and written
+	Missing []Segment // Segments the server didn't have, or refused for now
+	Broken  []Segment // Segments that were malformed or failed their CRC check
+}
+
+// Complete reports whether every segment of the file was written.
+func (r *Report) Complete() bool {
+	return r.Done == len(r.File.Segments)
+}
+
+// Create returns where to write a file being downloaded: f is the file in
+// the NZB, and name and size are as its yEnc headers give them. The
+// io.WriterAt must allow parallel calls to WriteAt, as *os.File does.
+type Create func(f *File, name string, size int64) (io.WriterAt, error)
+
+// Download fetches every segment of every file in n with Conn.Body,
+// spreading them over conns, which are used in parallel. Each segment is
+// yEnc decoded and written at its offset in the writer that create returns
+// for its file. create is called once per file, when its first segment
+// has been decoded.
+//
+// Missing, malformed and corrupt segments are noted in the reports, one per
+// file in n, and don't stop the download; so are segments the server
+// refuses with a temporary error, such as 436. Segments are fetched again
+// over other connections if theirs breaks. An error is returned if the
+// server refuses a segment for some other reason, if create or a write
+// fails, if every connection breaks, or if ctx is done; the reports then
+// cover what was done so far.
+func Download(ctx context.Context, n *NZB, conns []*nntp.Conn, create Create) ([]Report, error) {
+	if len(conns) == 0 {
+		return nil, errors.New("nzb: no connections to download with")
+	}
+
+	d := &download{
+		create:  create,
+		reports: make([]Report, len(n.Files)),
+		outs:    make([]io.WriterAt, len(n.Files)),
+		live:    len(conns),
+	}
+	d.cond = sync.NewCond(&d.mu)
+	for i := range n.Files {
+		d.reports[i].File = &n.Files[i]
+		for _, s := range n.Files[i].Segments {
+			d.todo = append(d.todo, job{i, s})
+		}
+	}
+
+	ctx, cancel := context.WithCancel(ctx)
+	defer cancel()
+
+	var wg sync.WaitGroup
+	for _, c := range conns {
+		wg.Add(1)
+		go func(c *nntp.Conn) {
+			defer wg.Done()
+			if err := d.work(ctx, c); err != nil {
+				cancel()
+			}
+		}(c)
+	}
+	wg.Wait()
+
+	for i := range d.reports {
+		r := &d.reports[i]
+		sort.Slice(r.Missing, func(a, b int) bool { return r.Missing[a].Number < r.Missing[b].Number })
+		sort.Slice(r.Broken, func(a, b int) bool { return r.Broken[a].Number < r.Broken[b].Number })
+	}
+	return d.reports, d.err
+}
+
+type job struct {
+	file    int
+	segment Segment
+}
+
+type download struct {
+	create Create
+
+	mu      sync.Mutex
+	cond    *sync.Cond // signalled when a job is done or requeued, or on error
+	todo    []job
+	busy    int // jobs being fetched
+	reports []Report
+	outs    []io.WriterAt
+	live    int // connections still working
+	err     error
+}
+
+// work downloads segments over c until there are none left, or c breaks.
+// It returns an error if the whole download should stop.
+func (d *download) work(ctx context.Context, c *nntp.Conn) error {
+	for {
+		j, ok := d.next()
+		if !ok {
+			return nil
+		}
+		if err := ctx.Err(); err != nil {
+			return d.quit(j, err, true)
+		}
+
+		h, data, err := fetch(ctx, c, j.segment)
+		var yerr nntp.YencError
+		switch {
+		case err == nil:
+			if err := d.write(j, h, data); err != nil {
+				return d.quit(j, err, true)
+			}
+			d.done(j, nil)
+		case ctx.Err() != nil:
+			return d.quit(j, ctx.Err(), true)
+		case errors.As(err, &yerr):
+			d.done(j, &d.reports[j.file].Broken)
+		case errors.Is(err, nntp.ErrNoSuchArticle), refused(err):
+			d.done(j, &d.reports[j.file].Missing)
+		case nntp.ErrorCode(err) != 0 && !errors.Is(err, nntp.ErrServiceDiscontinued):
+			return d.quit(j, err, true)
+		default:
+			// The connection is gone; leave the segment to the others.
+			return d.quit(j, err, false)
+		}
+	}
+}
+
+// refused reports whether err is the server declining to serve a segment
+// for the time being, rather than a problem with the download.
+func refused(err error) bool {
+	var nerr nntp.Error
+	if !errors.As(err, &nerr) || errors.Is(err, nntp.ErrServiceDiscontinued) || errors.Is(err, nntp.ErrAuthRequired) {
+		return false
+	}
+	return nerr.Temporary() || nerr.Code == 503
+}
+
+// next returns the next segment to fetch. If there is none, but other
+// workers are fetching segments they may yet requeue, it waits for them.
+// When it reports there's no more work, the worker is counted out.
+func (d *download) next() (job, bool) {
+	d.mu.Lock()
+	defer d.mu.Unlock()
+	for len(d.todo) == 0 && d.busy > 0 && d.err == nil {
+		d.cond.Wait()
+	}
+	if len(d.todo) == 0 || d.err != nil {
+		d.live--
+		return job{}, false
+	}
+	j := d.todo[0]
+	d.todo = d.todo[1:]
+	d.busy++
+	return j, true
+}
+
+// done records that j has been dealt with, noting its segment in segs if
+// that isn't nil.
+func (d *download) done(j job, segs *[]Segment) {
+	d.mu.Lock()
+	defer d.mu.Unlock()
+	if segs != nil {
+		*segs = append(*segs, j.segment)
+	}
+	d.busy--
+	d.cond.Broadcast()
+}
+
+// quit records that a worker is stopping because of err while fetching j.
+// Unless fatal is set, j is left to the other workers; err is only fatal
+// to the download if fatal is set, or if no other worker remains to do
+// what's left.
+func (d *download) quit(j job, err error, fatal bool) error {
+	d.mu.Lock()
+	defer d.mu.Unlock()
+	d.busy--
+	d.live--
+	defer d.cond.Broadcast()
+	if !fatal {
+		d.todo = append(d.todo, j)
+		if d.live > 0 {
+			return nil
+		}
+	}
+	if d.err == nil {
+		d.err = err
+	}
+	return err
+}
+
+// fetch gets a segment and decodes it.
+func fetch(ctx context.Context, c *nntp.Conn, s Segment) (nntp.YencHeader, []byte, error) {
+	r, err := c.BodyContext(ctx, s.ID())
+	if err != nil {
+		return nntp.YencHeader{}, nil, err
+	}
+	defer r.Close()
+	y, err := nntp.NewYencDecoder(r)
+	if err != nil {
+		return nntp.YencHeader{}, nil, err
+	}
+	data, err := ioutil.ReadAll(y)
+	return y.Header, data, err
+}
+
+// write writes a decoded segment to its file, creating the file first if
+// need be.
+func (d *download) write(j job, h nntp.YencHeader, data []byte) error {
+	d.mu.Lock()
+	r := &d.reports[j.file]
+	out := d.outs[j.file]
+	if out == nil {
+		var err error
+		if out, err = d.create(r.File, h.Name, h.Size); err != nil {
+			d.mu.Unlock()
+			return err
+		}
+		d.outs[j.file] = out
+		r.Name, r.Size = h.Name, h.Size
+	}
+	d.mu.Unlock()
+
+	offset := h.Begin - 1
+	if offset < 0 {
+		offset = 0
+	}
+	if _, err := out.WriteAt(data, offset); err != nil {
+		return err
+	}
+
+	d.mu.Lock()
+	r.Done++
+	d.mu.Unlock()
+	return nil
+}
@@ -0,0 +1,221 @@
+package nzb
+
+import (
+	"bufio"
+	"bytes"
+	"context"
+	"fmt"
+	"io"
+	"net"
+	"strings"
+	"sync"
+	"testing"
+
+	"github.com/willglynn/nntp"
+)
+
+// bodyServer runs a news server on the loopback interface that serves the
+// given bodies by message-id, to BODY and STAT. A body starting with "!"
+// is a response line to send instead, such as "!436 Try again later". A
+// body starting with "!drop" is served as what follows it, except that the
+// first connection to ask for it is dropped instead.
+func bodyServer(t *testing.T, bodies map[string]string) string {
+	l, err := net.Listen("tcp", "127.0.0.1:0")
+	if err != nil {
+		t.Fatal(err)
+	}
+	t.Cleanup(func() { l.Close() })
+
+	var mu sync.Mutex
+	dropped := make(map[string]bool)
+
+	go func() {
+		for {
+			c, err := l.Accept()
+			if err != nil {
+				return
+			}
+			go func() {
+				defer c.Close()
+				c.Write([]byte("200 hello\r\n"))
+				r := bufio.NewReader(c)
+				for {
+					line, err := r.ReadString('\n')
+					if err != nil {
+						return
+					}
+					ss := strings.Fields(line)
+					switch {
+					case len(ss) == 2 && ss[0] == "BODY":
+						if body, ok := bodies[ss[1]]; ok {
+							if strings.HasPrefix(body, "!drop") {
+								mu.Lock()
+								drop := !dropped[ss[1]]
+								dropped[ss[1]] = true
+								mu.Unlock()
+								if drop {
+									return
+								}
+								body = body[len("!drop"):]
+							}
+							if strings.HasPrefix(body, "!") {
+								fmt.Fprintf(c, "%s\r\n", body[1:])
+							} else {
+								fmt.Fprintf(c, "222 0 %s\r\n%s.\r\n", ss[1], body)
+							}
+						} else {
+							c.Write([]byte("430 No such article\r\n"))
+						}
+					case len(ss) == 2 && ss[0] == "STAT":
+						if body, ok := bodies[ss[1]]; ok && strings.HasPrefix(body, "!") {
+							fmt.Fprintf(c, "%s\r\n", body[1:])
+						} else if ok {
+							fmt.Fprintf(c, "223 0 %s\r\n", ss[1])
+						} else {
+							c.Write([]byte("430 No such article\r\n"))
+						}
+					case len(ss) == 1 && ss[0] == "QUIT":
+						c.Write([]byte("205 Bye!\r\n"))
+						return
+					default:
+						c.Write([]byte("500 What?\r\n"))
+					}
+				}
+			}()
+		}
+	}()
+	return l.Addr().String()
+}
+
+// yencPart encodes part of data as the body of an article.
+func yencPart(t *testing.T, name string, data []byte, part, total int, begin, end int64) string {
+	var buf bytes.Buffer
+	y := nntp.NewYencEncoder(&buf, nntp.YencHeader{
+		Name: name, Size: int64(len(data)), Part: part, Total: total, Begin: begin, End: end,
+	})
+	y.Write(data[begin-1 : end])
+	if err := y.Close(); err != nil {
+		t.Fatal(err)
+	}
+	return buf.String()
+}
+
+// memFile is an io.WriterAt in memory.
+type memFile struct {
+	mu   sync.Mutex
+	data []byte
+}
+
+func (m *memFile) WriteAt(p []byte, off int64) (int, error) {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	if need := int(off) + len(p); need > len(m.data) {
+		m.data = append(m.data, make([]byte, need-len(m.data))...)
+	}
+	return copy(m.data[off:], p), nil
+}
+
+func TestDownload(t *testing.T) {
+	data := make([]byte, 3000)
+	for i := range data {
+		data[i] = byte(i * 13)
+	}
+	corrupt := strings.Replace(yencPart(t, "b.bin", data, 2, 3, 1001, 2000), "pcrc32=", "pcrc32=1", 1)
+	addr := bodyServer(t, map[string]string{
+		"<a1@x>": yencPart(t, "a.bin", data, 1, 3, 1, 1000),
+		"<a2@x>": yencPart(t, "a.bin", data, 2, 3, 1001, 2000),
+		"<a3@x>": yencPart(t, "a.bin", data, 3, 3, 2001, 3000),
+		"<b1@x>": yencPart(t, "b.bin", data, 1, 3, 1, 1000),
+		"<b2@x>": corrupt,
+	})
+
+	n := &NZB{Files: []File{
+		{Subject: "a.bin (1/3)", Segments: []Segment{{Number: 3, MessageID: "a3@x"}, {Number: 1, MessageID: "a1@x"}, {Number: 2, MessageID: "a2@x"}}},
+		{Subject: "b.bin (1/3)", Segments: []Segment{{Number: 1, MessageID: "b1@x"}, {Number: 2, MessageID: "b2@x"}, {Number: 3, MessageID: "b3@x"}}},
+	}}
+
+	var conns []*nntp.Conn
+	for i := 0; i < 3; i++ {
+		c, err := nntp.Dial("tcp", addr)
+		if err != nil {
+			t.Fatal(err)
+		}
+		defer c.Quit()
+		conns = append(conns, c)
+	}
+
+	var mu sync.Mutex
+	files := make(map[string]*memFile)
+	reports, err := Download(context.Background(), n, conns, func(f *File, name string, size int64) (io.WriterAt, error) {
+		mu.Lock()
+		defer mu.Unlock()
+		if files[name] != nil || size != int64(len(data)) {
+			t.Errorf("unexpected create of %q, size %d", name, size)
+		}
+		files[name] = &memFile{}
+		return files[name], nil
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if r := reports[0]; !r.Complete() || r.Name != "a.bin" || len(r.Missing)+len(r.Broken) != 0 {
+		t.Fatalf("unexpected report for a.bin: %+v", r)
+	}
+	if !bytes.Equal(files["a.bin"].data, data) {
+		t.Fatal("a.bin was not assembled correctly")
+	}
+
+	r := reports[1]
+	if r.Complete() || r.Done != 1 || len(r.Missing) != 1 || r.Missing[0].Number != 3 || len(r.Broken) != 1 || r.Broken[0].Number != 2 {
+		t.Fatalf("unexpected report for b.bin: %+v", r)
+	}
+	if !bytes.Equal(files["b.bin"].data[:1000], data[:1000]) {
+		t.Fatal("b.bin's first segment was not written")
+	}
+}
+
+func TestDownloadBrokenConn(t *testing.T) {
+	data := make([]byte, 3000)
+	for i := range data {
+		data[i] = byte(i * 7)
+	}
+	n := &NZB{Files: []File{
+		{Subject: "a.bin (1/3)", Segments: []Segment{{Number: 1, MessageID: "a1@x"}, {Number: 2, MessageID: "a2@x"}, {Number: 3, MessageID: "a3@x"}}},
+		{Subject: "b.bin (1/1)", Segments: []Segment{{Number: 1, MessageID: "b1@x"}}},
+	}}
+
+	// One connection breaks, often while the other has nothing left to do;
+	// the segment it was fetching must not be lost.
+	for i := 0; i < 20; i++ {
+		addr := bodyServer(t, map[string]string{
+			"<a1@x>": yencPart(t, "a.bin", data, 1, 3, 1, 1000),
+			"<a2@x>": "!drop" + yencPart(t, "a.bin", data, 2, 3, 1001, 2000),
+			"<a3@x>": yencPart(t, "a.bin", data, 3, 3, 2001, 3000),
+			"<b1@x>": "!436 Try again later",
+		})
+		var conns []*nntp.Conn
+		for j := 0; j < 2; j++ {
+			c, err := nntp.Dial("tcp", addr)
+			if err != nil {
+				t.Fatal(err)
+			}
+			defer c.Quit()
+			conns = append(conns, c)
+		}
+
+		file := &memFile{}
+		reports, err := Download(context.Background(), n, conns, func(f *File, name string, size int64) (io.WriterAt, error) {
+			return file, nil
+		})
+		if err != nil {
+			t.Fatal(err)
+		}
+		if r := reports[0]; !r.Complete() || !bytes.Equal(file.data, data) {
+			t.Fatalf("a.bin should be complete after a connection broke: %+v", r)
+		}
+		if r := reports[1]; len(r.Missing) != 1 || r.Done != 0 {
+			t.Fatalf("a segment refused with 436 should be missing: %+v", r)
+		}
+	}
+}
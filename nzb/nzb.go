@@ -0,0 +1,168 @@
+// Package nzb reads and writes NZB files, which list the articles that
+// make up binaries posted to Usenet, as described by version 1.1 of the
+// NZB DTD.
+//
+// The message-ids of an NZB's segments can be passed, by way of
+// Segment.ID, straight to nntp.Conn.Body.
+package nzb
+
+import (
+	"bufio"
+	"encoding/xml"
+	"fmt"
+	"io"
+	"strings"
+	"time"
+	"unicode/utf8"
+)
+
+// Namespace is the XML namespace of NZB files.
+const Namespace = "http://www.newzbin.com/DTD/2003/nzb"
+
+const doctype = `<!DOCTYPE nzb PUBLIC "-//newzBin//DTD NZB 1.1//EN" "http://www.newzbin.com/DTD/nzb/nzb-1.1.dtd">`
+
+// An NZB is the contents of an NZB file.
+type NZB struct {
+	XMLName xml.Name // The root element, <nzb>
+	Meta    []Meta   `xml:"head>meta"`
+	Files   []File   `xml:"file"`
+}
+
+// A Meta is a piece of information about the NZB as a whole, such as its
+// "title", "password" or "category".
+type Meta struct {
+	Type  string `xml:"type,attr"`
+	Value string `xml:",chardata"`
+}
+
+// A File is a file posted as one or more articles.
+type File struct {
+	Poster   string    `xml:"poster,attr"`
+	Date     int64     `xml:"date,attr"` // Unix time the file was posted
+	Subject  string    `xml:"subject,attr"`
+	Groups   []string  `xml:"groups>group"`
+	Segments []Segment `xml:"segments>segment"`
+}
+
+// A Segment is one article of a File.
+type Segment struct {
+	Bytes     int64  `xml:"bytes,attr"`  // Size of the article
+	Number    int    `xml:"number,attr"` // Position in the file, from 1
+	MessageID string `xml:",chardata"`   // Message-id, without angle brackets
+}
+
+// Get returns the value of the first Meta of the given type, or "" if
+// there isn't one.
+func (n *NZB) Get(typ string) string {
+	for _, m := range n.Meta {
+		if m.Type == typ {
+			return m.Value
+		}
+	}
+	return ""
+}
+
+// Size returns the sum of the sizes of the file's segments.
+func (f *File) Size() int64 {
+	var size int64
+	for _, s := range f.Segments {
+		size += s.Bytes
+	}
+	return size
+}
+
+// Time returns the time the file was posted.
+func (f *File) Time() time.Time {
+	return time.Unix(f.Date, 0)
+}
+
+// ID returns the segment's message-id in angle brackets, as NNTP commands
+// expect it.
+func (s Segment) ID() string {
+	return "<" + strings.Trim(strings.TrimSpace(s.MessageID), "<>") + ">"
+}
+
+// Read parses an NZB file. Files in ISO-8859-1 are accepted as well as
+// UTF-8.
+func Read(r io.Reader) (*NZB, error) {
+	d := xml.NewDecoder(r)
+	d.CharsetReader = charsetReader
+
+	n := new(NZB)
+	if err := d.Decode(n); err != nil {
+		return nil, err
+	}
+	if n.XMLName.Local != "nzb" {
+		return nil, fmt.Errorf("nzb: unexpected root element %q", n.XMLName.Local)
+	}
+	for i := range n.Files {
+		for j := range n.Files[i].Segments {
+			s := &n.Files[i].Segments[j]
+			s.MessageID = strings.Trim(strings.TrimSpace(s.MessageID), "<>")
+		}
+	}
+	return n, nil
+}
+
+// WriteTo writes n as an NZB file, in UTF-8.
+func (n *NZB) WriteTo(w io.Writer) (int64, error) {
+	out := *n
+	out.XMLName = xml.Name{Space: Namespace, Local: "nzb"}
+
+	cw := &countingWriter{w: bufio.NewWriter(w)}
+	io.WriteString(cw, xml.Header+doctype+"\n")
+	e := xml.NewEncoder(cw)
+	e.Indent("", "\t")
+	if err := e.Encode(&out); err != nil {
+		return cw.n, err
+	}
+	io.WriteString(cw, "\n")
+	return cw.n, cw.w.Flush()
+}
+
+type countingWriter struct {
+	w *bufio.Writer
+	n int64
+}
+
+func (c *countingWriter) Write(p []byte) (int, error) {
+	n, err := c.w.Write(p)
+	c.n += int64(n)
+	return n, err
+}
+
+func charsetReader(charset string, r io.Reader) (io.Reader, error) {
+	switch strings.ToLower(charset) {
+	case "utf-8", "us-ascii":
+		return r, nil
+	case "iso-8859-1", "latin1":
+		return &latin1Reader{r: bufio.NewReader(r)}, nil
+	}
+	return nil, fmt.Errorf("nzb: unsupported charset %q", charset)
+}
+
+// latin1Reader converts ISO-8859-1 to UTF-8.
+type latin1Reader struct {
+	r   *bufio.Reader
+	buf [utf8.UTFMax]byte
+	out []byte
+}
+
+func (l *latin1Reader) Read(p []byte) (n int, err error) {
+	for n < len(p) {
+		if len(l.out) == 0 {
+			b, err := l.r.ReadByte()
+			if err != nil {
+				if n > 0 {
+					return n, nil
+				}
+				return 0, err
+			}
+			l.out = l.buf[:utf8.EncodeRune(l.buf[:], rune(b))]
+		}
+		c := copy(p[n:], l.out)
+		l.out = l.out[c:]
+		n += c
+	}
+	return n, nil
+}
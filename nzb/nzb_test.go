@@ -0,0 +1,79 @@
+package nzb
+
+import (
+	"bytes"
+	"strings"
+	"testing"
+)
+
+const sample = `<?xml version="1.0" encoding="iso-8859-1" ?>
+<!DOCTYPE nzb PUBLIC "-//newzBin//DTD NZB 1.1//EN" "http://www.newzbin.com/DTD/nzb/nzb-1.1.dtd">
+<nzb xmlns="http://www.newzbin.com/DTD/2003/nzb">
+ <head>
+   <meta type="title">Your File!</meta>
+   <meta type="password">secret</meta>
+ </head>
+ <file poster="Joe Bloggs &lt;bloggs@nowhere.example&gt;" date="1071674882" subject="Here's your file!  abc-mr2a.r01 (1/2)">
+   <groups>
+     <group>alt.binaries.newzbin</group>
+     <group>alt.binaries.mojo</group>
+   </groups>
+   <segments>
+     <segment bytes="102394" number="1">123456789abcdef@news.newzbin.com</segment>
+     <segment bytes="4501" number="2">987654321fedbca@news.newzbin.com</segment>
+   </segments>
+ </file>
+</nzb>
+`
+
+func TestRead(t *testing.T) {
+	n, err := Read(strings.NewReader(strings.Replace(sample, "Joe", "J\xf6e", 1)))
+	if err != nil {
+		t.Fatal(err)
+	}
+	if n.Get("title") != "Your File!" || n.Get("password") != "secret" || n.Get("category") != "" {
+		t.Fatalf("unexpected meta %+v", n.Meta)
+	}
+	if len(n.Files) != 1 {
+		t.Fatalf("expected 1 file, got %d", len(n.Files))
+	}
+	f := n.Files[0]
+	if f.Poster != "Jöe Bloggs <bloggs@nowhere.example>" || f.Date != 1071674882 || f.Subject != "Here's your file!  abc-mr2a.r01 (1/2)" {
+		t.Fatalf("unexpected file %+v", f)
+	}
+	if len(f.Groups) != 2 || f.Groups[1] != "alt.binaries.mojo" {
+		t.Fatalf("unexpected groups %q", f.Groups)
+	}
+	if f.Size() != 106895 || len(f.Segments) != 2 {
+		t.Fatalf("unexpected segments %+v", f.Segments)
+	}
+	if s := f.Segments[1]; s.Number != 2 || s.Bytes != 4501 || s.ID() != "<987654321fedbca@news.newzbin.com>" {
+		t.Fatalf("unexpected segment %+v", s)
+	}
+}
+
+func TestWriteTo(t *testing.T) {
+	n, err := Read(strings.NewReader(sample))
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	var buf bytes.Buffer
+	if _, err := n.WriteTo(&buf); err != nil {
+		t.Fatal(err)
+	}
+	out := buf.String()
+	if !strings.Contains(out, "<!DOCTYPE nzb") || !strings.Contains(out, `<nzb xmlns="`+Namespace+`">`) {
+		t.Fatalf("expected an NZB 1.1 preamble, got:\n%s", out)
+	}
+
+	again, err := Read(&buf)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(again.Files) != 1 || again.Files[0].Subject != n.Files[0].Subject ||
+		len(again.Files[0].Segments) != 2 || again.Files[0].Segments[0] != n.Files[0].Segments[0] ||
+		again.Get("title") != "Your File!" {
+		t.Fatalf("round trip changed the NZB:\n%s", out)
+	}
+}
@@ -0,0 +1,205 @@
+package nntp
+
+import (
+	"bufio"
+	"errors"
+	"io"
+	"strings"
+)
+
+// A Pipeline sends article commands without waiting for the response to
+// each before sending the next, as RFC 3977 section 3.5 allows, which
+// saves a round trip per command. Up to window commands are in flight at
+// once; the rest wait their turn.
+//
+// Commands are queued with Article, Head, Body and Stat, and their
+// responses read, in order, with Next. While a Pipeline is in use, no
+// other methods of its Conn may be called.
+type Pipeline struct {
+	c      *Conn
+	window int
+	queue  []string // commands awaiting responses, oldest first
+	sent   int      // how many of queue have been sent
+	err    error
+}
+
+// A Response is the server's response to a command sent by a Pipeline.
+type Response struct {
+	Command string // The command, e.g. "BODY <i.am.an.article@example.com>"
+	Err     error  // If the server refused the command, an Error saying why
+
+	Number    string        // Article number, as for Stat
+	MessageID string        // Message-id, as for Stat
+	Article   *Article      // For ARTICLE and HEAD, the article; HEAD leaves its Body nil
+	Body      io.ReadCloser // For BODY, the body
+}
+
+// Pipeline returns a Pipeline that keeps up to window commands in flight.
+func (c *Conn) Pipeline(window int) *Pipeline {
+	if window < 1 {
+		window = 1
+	}
+	return &Pipeline{c: c, window: window}
+}
+
+// Article queues an ARTICLE command for the article named by id.
+func (p *Pipeline) Article(id string) error {
+	return p.add(maybeId("ARTICLE", id))
+}
+
+// Head queues a HEAD command for the article named by id.
+func (p *Pipeline) Head(id string) error {
+	return p.add(maybeId("HEAD", id))
+}
+
+// Body queues a BODY command for the article named by id.
+func (p *Pipeline) Body(id string) error {
+	return p.add(maybeId("BODY", id))
+}
+
+// Stat queues a STAT command for the article named by id.
+func (p *Pipeline) Stat(id string) error {
+	return p.add(maybeId("STAT", id))
+}
+
+func (p *Pipeline) add(cmd string) error {
+	if p.err != nil {
+		return p.err
+	}
+	p.queue = append(p.queue, cmd)
+	// Sending now would cut short a body still being read.
+	if p.c.br == nil {
+		p.fill()
+	}
+	return p.err
+}
+
+// fill sends queued commands until window of them are in flight.
+func (p *Pipeline) fill() {
+	for p.err == nil && p.sent < len(p.queue) && p.sent < p.window {
+		if p.err = p.c.send("%s", p.queue[p.sent]); p.err == nil {
+			p.sent++
+		}
+	}
+}
+
+// Next returns the response to the oldest command awaiting one, or io.EOF
+// if there are none. The Article or Body of the response may only be read
+// until it is closed or Next is called again.
+//
+// Commands the server refuses have an Error in their response. Other
+// errors, such as network errors, end the exchange and are returned by
+// every later call.
+func (p *Pipeline) Next() (*Response, error) {
+	if p.err != nil {
+		return nil, p.err
+	}
+	if len(p.queue) == 0 {
+		return nil, io.EOF
+	}
+
+	c := p.c
+	if p.err = c.endBody(); p.err != nil {
+		return nil, p.err
+	}
+	if p.fill(); p.err != nil {
+		return nil, p.err
+	}
+
+	res := &Response{Command: p.queue[0]}
+	p.queue = p.queue[1:]
+	p.sent--
+
+	code, line, err := c.response(0)
+	if err != nil {
+		p.err = inResponseTo(err, res.Command)
+		return nil, p.err
+	}
+
+	cmd := strings.SplitN(res.Command, " ", 2)[0]
+	expect := map[string]uint{"ARTICLE": 220, "HEAD": 221, "BODY": 222, "STAT": 223}[cmd]
+	switch {
+	case code == expect:
+	case code/100 == 4 || code/100 == 5:
+		res.Err = Error{code, line}
+		if c.close {
+			p.err = res.Err
+		}
+		return res, nil
+	default:
+		p.err = c.fail(ProtocolError{Msg: "unexpected response", Line: line, Command: res.Command})
+		return nil, p.err
+	}
+
+	ss := strings.SplitN(line, " ", 3) // optional comment ignored
+	if len(ss) < 2 {
+		p.err = c.fail(ProtocolError{Msg: "bad response", Line: line, Command: res.Command})
+		return nil, p.err
+	}
+	res.Number, res.MessageID = ss[0], ss[1]
+
+	switch cmd {
+	case "ARTICLE", "HEAD":
+		br := c.body()
+		r := bufio.NewReader(br)
+		if res.Article, err = c.readHeader(r); err != nil {
+			p.err = c.fail(inResponseTo(err, res.Command))
+			return nil, p.err
+		}
+		if cmd == "ARTICLE" {
+			res.Article.Body = readCloser{r, br}
+		}
+	case "BODY":
+		res.Body = c.body()
+	}
+	return res, nil
+}
+
+// A StatResult is the server's answer to STAT for one article.
+type StatResult struct {
+	ID        string // The message-id or number asked about
+	Number    string // Article number, as for Stat
+	MessageID string // Message-id, as for Stat
+	Err       error  // ErrNoSuchArticle or ErrNoSuchArticleNumber if the article can't be had; nil if it exists
+}
+
+// StatMany checks whether each of the articles named by ids exists, as Stat
+// does, with a Pipeline that keeps up to window STAT commands in flight.
+// The results are in the order of ids.
+//
+// Articles the server reports it lacks (ErrNoSuchArticle or
+// ErrNoSuchArticleNumber) have that Error in their result. Any other
+// refusal, such as ErrAuthRequired, says nothing about the article, so it
+// is returned instead, as is an error in the exchange itself; the results
+// then cover the articles answered for before it.
+func (c *Conn) StatMany(ids []string, window int) ([]StatResult, error) {
+	p := c.Pipeline(window)
+	for _, id := range ids {
+		if err := p.Stat(id); err != nil {
+			return nil, err
+		}
+	}
+
+	var refusal error
+	results := make([]StatResult, 0, len(ids))
+	for _, id := range ids {
+		res, err := p.Next()
+		if err != nil {
+			return results, err
+		}
+		if c.close && res.Err != nil {
+			return results, res.Err
+		}
+		if refusal != nil {
+			// Read the rest of the responses, so the connection can
+			// still be used.
+			continue
+		}
+		if res.Err != nil && !errors.Is(res.Err, ErrNoSuchArticle) && !errors.Is(res.Err, ErrNoSuchArticleNumber) {
+			refusal = res.Err
+			continue
+		}
+		results = append(results, StatResult{ID: id, Number: res.Number, MessageID: res.MessageID, Err: res.Err})
+	}
+	return results, refusal
+}
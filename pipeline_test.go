@@ -0,0 +1,135 @@
+package nntp
+
+import (
+	"bufio"
+	"bytes"
+	"errors"
+	"io"
+	"io/ioutil"
+	"strings"
+	"testing"
+)
+
+func TestPipeline(t *testing.T) {
+	var cmdbuf bytes.Buffer
+	var fake faker
+	fake.Writer = &cmdbuf
+
+	server := "222 1 <a@x>\r\n" +
+		"first line\r\n" +
+		"second line\r\n" +
+		".\r\n" +
+		"430 No such article\r\n" +
+		"221 3 <c@x>\r\n" +
+		"Subject: c\r\n" +
+		".\r\n" +
+		"220 4 <d@x>\r\n" +
+		"Subject: d\r\n" +
+		"\r\n" +
+		"..dotted\r\n" +
+		".\r\n"
+	conn := &Conn{conn: fake, w: fake, r: bufio.NewReader(strings.NewReader(server))}
+
+	p := conn.Pipeline(2)
+	for _, queue := range []func(string) error{p.Body, p.Stat, p.Head, p.Article} {
+		if err := queue(""); err != nil {
+			t.Fatal(err)
+		}
+	}
+	if expected := "BODY\r\nSTAT\r\n"; cmdbuf.String() != expected {
+		t.Fatalf("expected only the window to be sent, got %q", cmdbuf.String())
+	}
+
+	res, err := p.Next()
+	if err != nil {
+		t.Fatal(err)
+	}
+	if res.Command != "BODY" || res.Number != "1" || res.MessageID != "<a@x>" {
+		t.Fatalf("unexpected response %+v", res)
+	}
+	// Read some of the body only; Next skips the rest.
+	if line, _ := bufio.NewReader(res.Body).ReadString('\n'); line != "first line\n" {
+		t.Fatalf("unexpected body line %q", line)
+	}
+
+	if res, err = p.Next(); err != nil || !errors.Is(res.Err, ErrNoSuchArticle) {
+		t.Fatalf("expected a missing article, got %+v, %v", res, err)
+	}
+	if res, err = p.Next(); err != nil || res.Article.Header.Get("Subject") != "c" || res.Article.Body != nil {
+		t.Fatalf("unexpected HEAD response %+v, %v", res, err)
+	}
+	if res, err = p.Next(); err != nil || res.Article.Header.Get("Subject") != "d" {
+		t.Fatalf("unexpected ARTICLE response %+v, %v", res, err)
+	}
+	if body, _ := ioutil.ReadAll(res.Article.Body); string(body) != ".dotted\n" {
+		t.Fatalf("unexpected article body %q", body)
+	}
+	if _, err = p.Next(); err != io.EOF {
+		t.Fatalf("expected io.EOF once all responses are read, got %v", err)
+	}
+	if expected := "BODY\r\nSTAT\r\nHEAD\r\nARTICLE\r\n"; cmdbuf.String() != expected {
+		t.Fatalf("Got: %q\nExpected: %q", cmdbuf.String(), expected)
+	}
+}
+
+func TestPipelineUnexpected(t *testing.T) {
+	var fake faker
+	fake.Writer = ioutil.Discard
+	conn := &Conn{conn: fake, w: fake, r: bufio.NewReader(strings.NewReader("223 0 <b@x>\r\n"))}
+
+	p := conn.Pipeline(2)
+	p.Body("<a@x>")
+	p.Stat("<b@x>")
+	_, err := p.Next()
+	if perr, ok := err.(ProtocolError); !ok || perr.Command != "BODY <a@x>" {
+		t.Fatalf("expected a protocol error in response to BODY <a@x>, got %v", err)
+	}
+	if !conn.close {
+		t.Fatal("an unexpected response should close the connection")
+	}
+}
+
+func TestStatMany(t *testing.T) {
+	var cmdbuf bytes.Buffer
+	var fake faker
+	fake.Writer = &cmdbuf
+
+	server := "223 0 <a@x>\r\n" +
+		"430 No such article\r\n" +
+		"223 0 <c@x>\r\n"
+	conn := &Conn{conn: fake, w: fake, r: bufio.NewReader(strings.NewReader(server))}
+
+	results, err := conn.StatMany([]string{"<a@x>", "<b@x>", "<c@x>"}, 2)
+	if err != nil {
+		t.Fatal("StatMany shouldn't error: " + err.Error())
+	}
+	if len(results) != 3 || results[0].MessageID != "<a@x>" || results[2].Err != nil {
+		t.Fatalf("unexpected results %+v", results)
+	}
+	if results[1].ID != "<b@x>" || !errors.Is(results[1].Err, ErrNoSuchArticle) {
+		t.Fatalf("expected <b@x> to be missing, got %+v", results[1])
+	}
+	if expected := "STAT <a@x>\r\nSTAT <b@x>\r\nSTAT <c@x>\r\n"; cmdbuf.String() != expected {
+		t.Fatalf("Got: %q\nExpected: %q", cmdbuf.String(), expected)
+	}
+
+	// Refusals other than for a missing article are returned, with the
+	// remaining responses read.
+	server = "223 0 <a@x>\r\n" +
+		"480 Authentication required\r\n" +
+		"480 Authentication required\r\n" +
+		"223 0 <d@x>\r\n"
+	conn = &Conn{conn: fake, w: fake, r: bufio.NewReader(strings.NewReader(server))}
+	if results, err := conn.StatMany([]string{"<a@x>", "<b@x>", "<c@x>"}, 2); !errors.Is(err, ErrAuthRequired) || len(results) != 1 {
+		t.Fatalf("expected one result and ErrAuthRequired, got %+v, %v", results, err)
+	}
+	if _, msgid, err := conn.Stat("<d@x>"); err != nil || msgid != "<d@x>" {
+		t.Fatalf("expected the connection to be usable, got %q, %v", msgid, err)
+	}
+
+	// The exchange failing ends it.
+	conn = &Conn{conn: fake, w: fake, r: bufio.NewReader(strings.NewReader("223 0 <a@x>\r\n"))}
+	if results, err := conn.StatMany([]string{"<a@x>", "<b@x>"}, 5); err == nil || len(results) != 1 {
+		t.Fatalf("expected one result and an error, got %+v, %v", results, err)
+	}
+}
@@ -0,0 +1,252 @@
+package nntp
+
+import (
+	"context"
+	"crypto/tls"
+	"errors"
+	"sync"
+	"time"
+)
+
+// A ServerConfig describes how to connect and log in to a news server.
+type ServerConfig struct {
+	Network string      // Network for net.Dial; "tcp" if empty
+	Addr    string      // Address of the server, e.g. "news.example.com:563"
+	TLS     *tls.Config // If non-nil, connect using TLS with this configuration
+
+	// If Username is set, the connection is authenticated with it.
+	Username, Password string
+
+	// If ModeReader is set, MODE READER is sent after logging in.
+	ModeReader bool
+
+	// MaxConns limits the number of connections a Pool makes to the
+	// server. If zero, a single connection is used.
+	MaxConns int
+
+	// IdleTimeout is how long a Pool keeps a connection it isn't using.
+	// Servers drop idle connections, so this should be shorter than the
+	// server's own timeout. If zero, idle connections are kept forever.
+	IdleTimeout time.Duration
+}
+
+// Dial connects to the server and logs in, as configured.
+func (cfg *ServerConfig) Dial(ctx context.Context) (*Conn, error) {
+	network := cfg.Network
+	if network == "" {
+		network = "tcp"
+	}
+
+	var c *Conn
+	var err error
+	if cfg.TLS != nil {
+		c, err = DialTLSContext(ctx, network, cfg.Addr, cfg.TLS)
+	} else {
+		c, err = DialContext(ctx, network, cfg.Addr)
+	}
+	if err != nil {
+		return nil, err
+	}
+
+	if cfg.Username != "" {
+		err = c.AuthenticateContext(ctx, cfg.Username, cfg.Password)
+	}
+	if err == nil && cfg.ModeReader {
+		err = c.ModeReaderContext(ctx)
+	}
+	if err != nil {
+		c.fail(err)
+		return nil, err
+	}
+	return c, nil
+}
+
+// ErrPoolClosed is returned by Pool.Get once the pool is closed.
+var ErrPoolClosed = errors.New("pool closed")
+
+// A Pool shares connections to a single news server among goroutines,
+// never making more than the configured maximum number of connections.
+// Connections are made, and logged in, as they are needed.
+//
+// A Pool is safe for concurrent use; the connections it hands out are not.
+type Pool struct {
+	cfg ServerConfig
+
+	// sem holds a token for every open connection, idle or not.
+	sem  chan struct{}
+	idle chan idleConn
+	done chan struct{} // closed by Close
+
+	mu     sync.Mutex
+	out    map[*Conn]bool // connections handed out by Get
+	closed bool
+}
+
+type idleConn struct {
+	c     *Conn
+	since time.Time
+}
+
+// NewPool returns a Pool of connections to the server described by cfg.
+func NewPool(cfg ServerConfig) *Pool {
+	if cfg.MaxConns < 1 {
+		cfg.MaxConns = 1
+	}
+	return &Pool{
+		cfg:  cfg,
+		sem:  make(chan struct{}, cfg.MaxConns),
+		idle: make(chan idleConn, cfg.MaxConns),
+		done: make(chan struct{}),
+		out:  make(map[*Conn]bool),
+	}
+}
+
+// Config returns the configuration of the server the pool connects to.
+func (p *Pool) Config() ServerConfig {
+	return p.cfg
+}
+
+// Get returns an idle connection from the pool, or a new one if there are
+// fewer than the maximum number of connections. Otherwise it waits until a
+// connection is returned with Put, ctx is done, or the pool is closed.
+//
+// The connection must be handed back with Put once the caller is done
+// with it.
+func (p *Pool) Get(ctx context.Context) (*Conn, error) {
+	for {
+		p.mu.Lock()
+		closed := p.closed
+		p.mu.Unlock()
+		if closed {
+			return nil, ErrPoolClosed
+		}
+
+		// Prefer an idle connection to making a new one.
+		select {
+		case ic := <-p.idle:
+			if p.usable(ic) {
+				return p.checkout(ic.c)
+			}
+			continue
+		default:
+		}
+
+		select {
+		case ic := <-p.idle:
+			if p.usable(ic) {
+				return p.checkout(ic.c)
+			}
+		case p.sem <- struct{}{}:
+			// The pool may have been closed as the token was taken.
+			p.mu.Lock()
+			closed := p.closed
+			p.mu.Unlock()
+			if closed {
+				<-p.sem
+				return nil, ErrPoolClosed
+			}
+			c, err := p.cfg.Dial(ctx)
+			if err != nil {
+				<-p.sem
+				return nil, err
+			}
+			return p.checkout(c)
+		case <-p.done:
+			return nil, ErrPoolClosed
+		case <-ctx.Done():
+			return nil, ctx.Err()
+		}
+	}
+}
+
+// checkout records that c has been handed out, or if the pool has been
+// closed meanwhile, closes it.
+func (p *Pool) checkout(c *Conn) (*Conn, error) {
+	p.mu.Lock()
+	defer p.mu.Unlock()
+	if p.closed {
+		p.discard(c)
+		return nil, ErrPoolClosed
+	}
+	p.out[c] = true
+	return c, nil
+}
+
+// usable reports whether an idle connection may be handed out, closing it
+// if not.
+func (p *Pool) usable(ic idleConn) bool {
+	if p.cfg.IdleTimeout > 0 && time.Since(ic.since) > p.cfg.IdleTimeout {
+		p.discard(ic.c)
+		return false
+	}
+	return true
+}
+
+// Put returns a connection obtained from Get to the pool. Connections that
+// can't be used again, because of a network or protocol error or because
+// the server discontinued service, are closed instead. Connections that
+// didn't come from the pool's Get, or that were already put back, are
+// ignored.
+func (p *Pool) Put(c *Conn) {
+	p.mu.Lock()
+	if !p.out[c] {
+		p.mu.Unlock()
+		return
+	}
+	delete(p.out, c)
+	closed := p.closed
+	p.mu.Unlock()
+
+	if closed || c.close {
+		p.discard(c)
+		return
+	}
+	select {
+	case p.idle <- idleConn{c, time.Now()}:
+	default:
+		p.discard(c)
+		return
+	}
+
+	// The pool may have been closed while c was being put back.
+	p.mu.Lock()
+	defer p.mu.Unlock()
+	if p.closed {
+		p.discardIdle()
+	}
+}
+
+// discard closes a connection and gives up its place in the pool.
+func (p *Pool) discard(c *Conn) {
+	if !c.close {
+		c.fail(nil)
+	}
+	<-p.sem
+}
+
+// Close closes the idle connections in the pool, and makes callers of Get
+// waiting for a connection return ErrPoolClosed. Connections in use are
+// closed when they are returned with Put.
+func (p *Pool) Close() error {
+	p.mu.Lock()
+	defer p.mu.Unlock()
+
+	if !p.closed {
+		p.closed = true
+		close(p.done)
+	}
+	p.discardIdle()
+	return nil
+}
+
+// discardIdle closes the idle connections.
+func (p *Pool) discardIdle() {
+	for {
+		select {
+		case ic := <-p.idle:
+			p.discard(ic.c)
+		default:
+			return
+		}
+	}
+}
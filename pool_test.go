@@ -0,0 +1,229 @@
+package nntp
+
+import (
+	"bufio"
+	"context"
+	"net"
+	"strings"
+	"sync/atomic"
+	"testing"
+	"time"
+)
+
+// testServer runs a news server on the loopback interface that answers
+// each command with respond(command). It returns the server's address and
+// a counter of the connections it has accepted.
+func testServer(t *testing.T, respond func(cmd string) string) (string, *int32) {
+	l, err := net.Listen("tcp", "127.0.0.1:0")
+	if err != nil {
+		t.Fatal(err)
+	}
+	t.Cleanup(func() { l.Close() })
+
+	var accepted int32
+	go func() {
+		for {
+			c, err := l.Accept()
+			if err != nil {
+				return
+			}
+			atomic.AddInt32(&accepted, 1)
+			go func() {
+				defer c.Close()
+				c.Write([]byte("200 hello\r\n"))
+				r := bufio.NewReader(c)
+				for {
+					line, err := r.ReadString('\n')
+					if err != nil {
+						return
+					}
+					resp := respond(strings.TrimSpace(line))
+					c.Write([]byte(resp))
+					if strings.HasPrefix(resp, "205 ") || strings.HasPrefix(resp, "400 ") {
+						return
+					}
+				}
+			}()
+		}
+	}()
+	return l.Addr().String(), &accepted
+}
+
+func poolResponse(cmd string) string {
+	switch {
+	case cmd == "AUTHINFO USER user":
+		return "381 Password required\r\n"
+	case cmd == "AUTHINFO PASS pass":
+		return "281 Authentication accepted\r\n"
+	case cmd == "DATE":
+		return "111 20100329034158\r\n"
+	case cmd == "GROUP closing":
+		return "400 Service discontinued\r\n"
+	case cmd == "QUIT":
+		return "205 Bye!\r\n"
+	}
+	return "500 What?\r\n"
+}
+
+func TestPool(t *testing.T) {
+	addr, accepted := testServer(t, poolResponse)
+	p := NewPool(ServerConfig{Addr: addr, Username: "user", Password: "pass", MaxConns: 2})
+	defer p.Close()
+
+	ctx := context.Background()
+	c1, err := p.Get(ctx)
+	if err != nil {
+		t.Fatal("Get shouldn't error: " + err.Error())
+	}
+	c2, err := p.Get(ctx)
+	if err != nil {
+		t.Fatal("Get shouldn't error: " + err.Error())
+	}
+
+	tctx, cancel := context.WithTimeout(ctx, 50*time.Millisecond)
+	defer cancel()
+	if _, err := p.Get(tctx); err != context.DeadlineExceeded {
+		t.Fatalf("Get beyond MaxConns should wait, got %v", err)
+	}
+
+	// A waiting Get gets the next connection returned.
+	got := make(chan *Conn)
+	go func() {
+		c, _ := p.Get(ctx)
+		got <- c
+	}()
+	p.Put(c1)
+	if c := <-got; c != c1 {
+		t.Fatal("Get should reuse the idle connection")
+	}
+	if _, err := c1.Date(); err != nil {
+		t.Fatal("reused connection should work: " + err.Error())
+	}
+
+	// A connection the server gave up on isn't reused.
+	if _, err := c2.Group("closing"); ErrorCode(err) != 400 {
+		t.Fatalf("expected a 400 error, got %v", err)
+	}
+	p.Put(c2)
+	c3, err := p.Get(ctx)
+	if err != nil {
+		t.Fatal("Get shouldn't error: " + err.Error())
+	}
+	if c3 == c2 {
+		t.Fatal("a discontinued connection shouldn't be reused")
+	}
+	if _, err := c3.Date(); err != nil {
+		t.Fatal("new connection should work: " + err.Error())
+	}
+	if n := atomic.LoadInt32(accepted); n != 3 {
+		t.Fatalf("expected 3 connections to the server, got %d", n)
+	}
+
+	p.Put(c1)
+	p.Put(c3)
+	p.Close()
+	if _, err := p.Get(ctx); err != ErrPoolClosed {
+		t.Fatalf("Get on a closed pool should fail, got %v", err)
+	}
+}
+
+func TestPoolIdleTimeout(t *testing.T) {
+	addr, accepted := testServer(t, poolResponse)
+	p := NewPool(ServerConfig{Addr: addr, IdleTimeout: time.Millisecond})
+	defer p.Close()
+
+	c, err := p.Get(context.Background())
+	if err != nil {
+		t.Fatal("Get shouldn't error: " + err.Error())
+	}
+	p.Put(c)
+	time.Sleep(5 * time.Millisecond)
+
+	if c2, err := p.Get(context.Background()); err != nil {
+		t.Fatal("Get shouldn't error: " + err.Error())
+	} else if c2 == c {
+		t.Fatal("a connection idle for too long shouldn't be reused")
+	}
+	if n := atomic.LoadInt32(accepted); n != 2 {
+		t.Fatalf("expected 2 connections to the server, got %d", n)
+	}
+}
+
+func TestPoolPutMisuse(t *testing.T) {
+	addr, accepted := testServer(t, poolResponse)
+	p := NewPool(ServerConfig{Addr: addr})
+
+	c, err := p.Get(context.Background())
+	if err != nil {
+		t.Fatal("Get shouldn't error: " + err.Error())
+	}
+	foreign, err := Dial("tcp", addr)
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer foreign.Quit()
+
+	done := make(chan struct{})
+	go func() {
+		p.Put(c)
+		p.Put(c)
+		p.Put(foreign)
+		close(done)
+	}()
+	select {
+	case <-done:
+	case <-time.After(time.Second):
+		t.Fatal("Put blocked on a connection already put back or not from the pool")
+	}
+	if foreign.close {
+		t.Fatal("Put shouldn't close a connection that isn't the pool's")
+	}
+
+	if c2, err := p.Get(context.Background()); err != nil || c2 != c {
+		t.Fatalf("expected the idle connection back, got %v, %v", c2, err)
+	}
+	if n := atomic.LoadInt32(accepted); n != 2 {
+		t.Fatalf("expected 2 connections to the server, got %d", n)
+	}
+	p.Put(c)
+	if err := p.Close(); err != nil {
+		t.Fatal(err)
+	}
+	if !c.close {
+		t.Fatal("Close should close idle connections")
+	}
+}
+
+func TestPoolCloseWhileWaiting(t *testing.T) {
+	addr, _ := testServer(t, poolResponse)
+	p := NewPool(ServerConfig{Addr: addr})
+
+	c, err := p.Get(context.Background())
+	if err != nil {
+		t.Fatal("Get shouldn't error: " + err.Error())
+	}
+
+	got := make(chan error)
+	go func() {
+		_, err := p.Get(context.Background())
+		got <- err
+	}()
+	time.Sleep(10 * time.Millisecond)
+	p.Close()
+	select {
+	case err := <-got:
+		if err != ErrPoolClosed {
+			t.Fatalf("expected ErrPoolClosed, got %v", err)
+		}
+	case <-time.After(time.Second):
+		t.Fatal("Get waiting for a connection didn't notice Close")
+	}
+
+	p.Put(c)
+	if !c.close {
+		t.Fatal("Put after Close should close the connection")
+	}
+	if _, err := p.Get(context.Background()); err != ErrPoolClosed {
+		t.Fatalf("Get on a closed pool should fail, got %v", err)
+	}
+}
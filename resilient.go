@@ -0,0 +1,208 @@
+package nntp
+
+import (
+	"context"
+	"io"
+)
+
+// A ResilientConn is a connection to a news server that survives the
+// server dropping it. It remembers how it was set up: the login and
+// MODE READER of its ServerConfig, the selected group, and whether
+// compression was enabled.
+//
+// If one of its commands fails because the connection broke, or because
+// the server answered 480 (authentication required) or 400 (service
+// discontinued), it dials the server again, restores that state, and
+// retries the command once. Only commands that are safe to repeat are
+// offered.
+//
+// Readers returned by Body and Article are not retried: if the connection
+// breaks while one is being read, the read fails, and the next command
+// reconnects.
+type ResilientConn struct {
+	cfg ServerConfig
+	c   *Conn
+
+	group    string // group to select after reconnecting, if any
+	compress bool   // EnableCompression was called
+	deflate  bool   // Compress was called
+
+	closed bool
+}
+
+// NewResilientConn connects to the server described by cfg and returns a
+// ResilientConn for it.
+func NewResilientConn(ctx context.Context, cfg ServerConfig) (*ResilientConn, error) {
+	rc := &ResilientConn{cfg: cfg}
+	if err := rc.reconnect(ctx); err != nil {
+		return nil, err
+	}
+	return rc, nil
+}
+
+// Conn returns the current connection, or nil if the last attempt to
+// reconnect failed. It changes whenever the ResilientConn reconnects, and
+// commands sent on it directly are not retried.
+func (rc *ResilientConn) Conn() *Conn {
+	return rc.c
+}
+
+// reconnect closes the current connection, if any, and dials a new one,
+// restoring compression and the selected group.
+func (rc *ResilientConn) reconnect(ctx context.Context) error {
+	if rc.c != nil {
+		if !rc.c.close {
+			rc.c.fail(nil)
+		}
+		rc.c = nil
+	}
+
+	c, err := rc.cfg.Dial(ctx)
+	if err != nil {
+		return err
+	}
+	if rc.deflate {
+		err = c.CompressContext(ctx)
+	} else if rc.compress {
+		err = c.EnableCompressionContext(ctx)
+	}
+	if err == nil && rc.group != "" {
+		_, err = c.GroupContext(ctx, rc.group)
+	}
+	if err != nil {
+		c.fail(nil)
+		return err
+	}
+	rc.c = c
+	return nil
+}
+
+// retryable reports whether err means the command is worth sending again
+// on a new connection.
+func (rc *ResilientConn) retryable(err error) bool {
+	switch ErrorCode(err) {
+	case 400, 480:
+		return true
+	case 0:
+		return rc.c.close && !IsProtocol(err)
+	}
+	return false
+}
+
+// do runs f on the connection, reconnecting and running it again if it
+// fails in a way a new connection might fix.
+func (rc *ResilientConn) do(ctx context.Context, f func(c *Conn) error) error {
+	if rc.closed {
+		return ErrConnClosed
+	}
+	if rc.c == nil {
+		if err := rc.reconnect(ctx); err != nil {
+			return err
+		}
+	}
+
+	err := f(rc.c)
+	if err == nil || ctx.Err() != nil || !rc.retryable(err) {
+		return err
+	}
+	if err := rc.reconnect(ctx); err != nil {
+		return err
+	}
+	return f(rc.c)
+}
+
+// Group selects a group, as Conn.Group does. The group is selected again
+// whenever the connection is remade.
+func (rc *ResilientConn) Group(ctx context.Context, group string) (status *Group, err error) {
+	err = rc.do(ctx, func(c *Conn) (err error) {
+		status, err = c.GroupContext(ctx, group)
+		return
+	})
+	if err == nil {
+		rc.group = group
+	}
+	return
+}
+
+// EnableCompression turns on compression of headers, as
+// Conn.EnableCompression does. It is turned on again whenever the
+// connection is remade.
+func (rc *ResilientConn) EnableCompression(ctx context.Context) error {
+	err := rc.do(ctx, func(c *Conn) error {
+		return c.EnableCompressionContext(ctx)
+	})
+	if err == nil {
+		rc.compress = true
+	}
+	return err
+}
+
+// Compress turns on compression of the whole session, as Conn.Compress
+// does. It is turned on again whenever the connection is remade.
+func (rc *ResilientConn) Compress(ctx context.Context) error {
+	err := rc.do(ctx, func(c *Conn) error {
+		return c.CompressContext(ctx)
+	})
+	if err == nil {
+		rc.deflate = true
+	}
+	return err
+}
+
+// Stat checks whether an article exists, as Conn.Stat does.
+func (rc *ResilientConn) Stat(ctx context.Context, id string) (number, msgid string, err error) {
+	err = rc.do(ctx, func(c *Conn) (err error) {
+		number, msgid, err = c.StatContext(ctx, id)
+		return
+	})
+	return
+}
+
+// Article returns the article named by id, as Conn.Article does.
+func (rc *ResilientConn) Article(ctx context.Context, id string) (a *Article, err error) {
+	err = rc.do(ctx, func(c *Conn) (err error) {
+		a, err = c.ArticleContext(ctx, id)
+		return
+	})
+	return
+}
+
+// Head returns the headers of the article named by id, as Conn.Head does.
+func (rc *ResilientConn) Head(ctx context.Context, id string) (a *Article, err error) {
+	err = rc.do(ctx, func(c *Conn) (err error) {
+		a, err = c.HeadContext(ctx, id)
+		return
+	})
+	return
+}
+
+// Body returns the body of the article named by id, as Conn.Body does.
+func (rc *ResilientConn) Body(ctx context.Context, id string) (r io.ReadCloser, err error) {
+	err = rc.do(ctx, func(c *Conn) (err error) {
+		r, err = c.BodyContext(ctx, id)
+		return
+	})
+	return
+}
+
+// Overview returns overviews of the articles in the selected group, as
+// Conn.Overview does.
+func (rc *ResilientConn) Overview(ctx context.Context, begin, end int64) (overviews []MessageOverview, err error) {
+	err = rc.do(ctx, func(c *Conn) (err error) {
+		overviews, err = c.OverviewContext(ctx, begin, end)
+		return
+	})
+	return
+}
+
+// Close ends the session with QUIT and closes the connection.
+func (rc *ResilientConn) Close() error {
+	if rc.closed {
+		return ErrConnClosed
+	}
+	rc.closed = true
+	if rc.c == nil || rc.c.close {
+		return nil
+	}
+	return rc.c.Quit()
+}
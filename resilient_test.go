@@ -0,0 +1,83 @@
+package nntp
+
+import (
+	"context"
+	"strings"
+	"sync"
+	"sync/atomic"
+	"testing"
+)
+
+func TestResilientConn(t *testing.T) {
+	var mu sync.Mutex
+	var cmds []string
+	var stats int32
+	addr, accepted := testServer(t, func(cmd string) string {
+		mu.Lock()
+		cmds = append(cmds, cmd)
+		mu.Unlock()
+
+		switch {
+		case cmd == "GROUP misc.test":
+			return "211 1234 3000234 3002322 misc.test\r\n"
+		case strings.HasPrefix(cmd, "STAT "):
+			// The first STAT finds the session timed out, and the third
+			// and fourth want a login again.
+			switch atomic.AddInt32(&stats, 1) {
+			case 1:
+				return "400 Idle timeout\r\n"
+			case 3, 4:
+				return "480 Authentication required\r\n"
+			}
+			return "223 3000234 <45223423@example.com>\r\n"
+		}
+		return poolResponse(cmd)
+	})
+
+	ctx := context.Background()
+	rc, err := NewResilientConn(ctx, ServerConfig{Addr: addr, Username: "user", Password: "pass"})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if _, err := rc.Group(ctx, "misc.test"); err != nil {
+		t.Fatal("Group shouldn't error: " + err.Error())
+	}
+
+	if _, msgid, err := rc.Stat(ctx, "3000234"); err != nil {
+		t.Fatal("Stat shouldn't error after reconnecting: " + err.Error())
+	} else if msgid != "<45223423@example.com>" {
+		t.Fatalf("unexpected message-id %q", msgid)
+	}
+
+	// A command is retried once only.
+	if _, _, err := rc.Stat(ctx, "3000234"); ErrorCode(err) != 480 {
+		t.Fatalf("expected the 480 to be returned once retried, got %v", err)
+	}
+	if _, _, err := rc.Stat(ctx, "3000234"); err != nil {
+		t.Fatal("Stat shouldn't error: " + err.Error())
+	}
+
+	if n := atomic.LoadInt32(accepted); n != 3 {
+		t.Fatalf("expected 3 connections, got %d", n)
+	}
+
+	mu.Lock()
+	got := strings.Join(cmds, "\n")
+	mu.Unlock()
+	login := "CAPABILITIES\nAUTHINFO USER user\nAUTHINFO PASS pass\nGROUP misc.test"
+	expected := strings.Join([]string{
+		login, "STAT 3000234",
+		login, "STAT 3000234", "STAT 3000234",
+		login, "STAT 3000234", "STAT 3000234",
+	}, "\n")
+	if got != expected {
+		t.Fatalf("expected commands:\n%s\ngot:\n%s", expected, got)
+	}
+
+	if err := rc.Close(); err != nil {
+		t.Fatal("Close shouldn't error: " + err.Error())
+	}
+	if _, _, err := rc.Stat(ctx, "3000234"); err != ErrConnClosed {
+		t.Fatalf("expected ErrConnClosed after Close, got %v", err)
+	}
+}
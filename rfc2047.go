@@ -0,0 +1,398 @@
+package nntp
+
+import (
+	"bytes"
+	"encoding/base64"
+	"fmt"
+	"io"
+	"io/ioutil"
+	"mime"
+	"net/mail"
+	"strings"
+	"unicode/utf8"
+)
+
+// DefaultFallbackCharset is the charset raw 8-bit header values are taken
+// to be in if they aren't valid UTF-8 and no other charset is given.
+const DefaultFallbackCharset = "windows-1252"
+
+// A HeaderDecoder decodes header values, such as subjects and From lines,
+// into UTF-8. It understands RFC 2047 encoded-words ("=?UTF-8?B?...?=")
+// in the B and Q encodings, in UTF-8, US-ASCII, ISO-8859-1 to 16,
+// windows-1250 to 1258, KOI8-R and KOI8-U.
+//
+// Many posting agents put raw 8-bit text in headers instead, in whatever
+// charset their user had. Text that isn't valid UTF-8 is read as being in
+// FallbackCharset.
+type HeaderDecoder struct {
+	// FallbackCharset is the charset of raw 8-bit text that isn't valid
+	// UTF-8. If empty, DefaultFallbackCharset is used.
+	FallbackCharset string
+}
+
+var defaultHeaderDecoder HeaderDecoder
+
+// Decode returns s decoded into UTF-8. If an encoded-word names a charset
+// the decoder doesn't know, Decode returns s with only its raw 8-bit text
+// decoded, and an error. Malformed encoded-words are left as they are.
+func (d *HeaderDecoder) Decode(s string) (string, error) {
+	if !utf8.ValidString(s) {
+		fallback := d.FallbackCharset
+		if fallback == "" {
+			fallback = DefaultFallbackCharset
+		}
+		var err error
+		if s, err = decodeCharset(fallback, []byte(s)); err != nil {
+			return s, err
+		}
+	}
+	if !strings.Contains(s, "=?") {
+		return s, nil
+	}
+	wd := mime.WordDecoder{CharsetReader: charsetReader}
+	decoded, err := wd.DecodeHeader(s)
+	if err != nil {
+		return s, err
+	}
+	return strings.ToValidUTF8(decoded, "�"), nil
+}
+
+// DecodeHeader decodes a header value into UTF-8 with the default
+// HeaderDecoder, giving up on encoded-words in unknown charsets.
+func DecodeHeader(s string) string {
+	decoded, _ := defaultHeaderDecoder.Decode(s)
+	return decoded
+}
+
+// DecodedSubject returns the Subject of the overview decoded with
+// DecodeHeader.
+func (ov MessageOverview) DecodedSubject() string {
+	return DecodeHeader(ov.Subject)
+}
+
+// DecodedFrom returns the From of the overview decoded with DecodeHeader.
+func (ov MessageOverview) DecodedFrom() string {
+	return DecodeHeader(ov.From)
+}
+
+// DecodedSubject returns the article's Subject header decoded with
+// DecodeHeader.
+func (a *Article) DecodedSubject() string {
+	return DecodeHeader(a.Header.Get("Subject"))
+}
+
+// DecodedFrom returns the article's From header decoded with
+// DecodeHeader.
+func (a *Article) DecodedFrom() string {
+	return DecodeHeader(a.Header.Get("From"))
+}
+
+// charsetAliases maps other names in use for the charsets in charsets to
+// the names they are listed under.
+var charsetAliases = map[string]string{
+	"latin1":       "iso-8859-1",
+	"latin2":       "iso-8859-2",
+	"latin3":       "iso-8859-3",
+	"latin4":       "iso-8859-4",
+	"cyrillic":     "iso-8859-5",
+	"arabic":       "iso-8859-6",
+	"greek":        "iso-8859-7",
+	"hebrew":       "iso-8859-8",
+	"latin5":       "iso-8859-9",
+	"latin6":       "iso-8859-10",
+	"latin7":       "iso-8859-13",
+	"latin8":       "iso-8859-14",
+	"latin9":       "iso-8859-15",
+	"latin10":      "iso-8859-16",
+	"iso-8859-8-i": "iso-8859-8",
+	"tis-620":      "iso-8859-11",
+	"koi8":         "koi8-r",
+}
+
+// lookupCharset returns the table for the named charset, or nil if it
+// isn't a single-byte charset the package knows.
+func lookupCharset(name string) *[128]rune {
+	name = strings.ToLower(strings.TrimSpace(name))
+	if alias, ok := charsetAliases[name]; ok {
+		name = alias
+	}
+	switch {
+	case strings.HasPrefix(name, "iso8859-"), strings.HasPrefix(name, "iso_8859-"):
+		name = "iso-8859-" + name[strings.IndexByte(name, '-')+1:]
+	case strings.HasPrefix(name, "cp125"), strings.HasPrefix(name, "win125"):
+		name = "windows-" + strings.TrimLeft(name, "cpwin")
+	}
+	return charsets[name]
+}
+
+// decodeCharset decodes b, which is text in the named charset, into UTF-8.
+func decodeCharset(charset string, b []byte) (string, error) {
+	switch strings.ToLower(charset) {
+	case "utf-8", "utf8", "us-ascii", "ascii":
+		return strings.ToValidUTF8(string(b), "�"), nil
+	}
+	table := lookupCharset(charset)
+	if table == nil {
+		return string(b), fmt.Errorf("unknown charset %q", charset)
+	}
+	var sb strings.Builder
+	sb.Grow(len(b))
+	for _, c := range b {
+		if c < 0x80 {
+			sb.WriteByte(c)
+		} else {
+			sb.WriteRune(table[c-0x80])
+		}
+	}
+	return sb.String(), nil
+}
+
+// charsetReader is the CharsetReader of a mime.WordDecoder for the
+// charsets the package knows.
+func charsetReader(charset string, input io.Reader) (io.Reader, error) {
+	b, err := ioutil.ReadAll(input)
+	if err != nil {
+		return nil, err
+	}
+	s, err := decodeCharset(charset, b)
+	if err != nil {
+		return nil, err
+	}
+	return strings.NewReader(s), nil
+}
+
+// maxHeaderLine is the length lines of encoded header values are folded
+// to, as RFC 5322 recommends.
+const maxHeaderLine = 78
+
+// addressHeaders are the headers whose values are lists of addresses, in
+// which only the display names may be encoded.
+var addressHeaders = map[string]bool{
+	"from":           true,
+	"sender":         true,
+	"reply-to":       true,
+	"approved":       true,
+	"mail-copies-to": true,
+}
+
+// identifierHeaders are the headers made of message-ids, newsgroup names
+// and the like, which may not be encoded (RFC 2047 section 5).
+var identifierHeaders = map[string]bool{
+	"message-id":   true,
+	"references":   true,
+	"in-reply-to":  true,
+	"supersedes":   true,
+	"newsgroups":   true,
+	"followup-to":  true,
+	"distribution": true,
+	"path":         true,
+	"xref":         true,
+}
+
+// encodeArticle returns a copy of a ready to post: header values with
+// non-ASCII text are encoded, and MIME headers are added for a body of
+// non-ASCII UTF-8 text. The body is read into memory to check it.
+func encodeArticle(a *Article) (*Article, error) {
+	e := &Article{Header: make(Header, 0, len(a.Header)+3), Body: a.Body}
+	for _, f := range a.Header {
+		e.Header.Add(f.Key, EncodeHeader(f.Key, f.Value))
+	}
+
+	if a.Body == nil || e.Header.Get("Content-Type") != "" {
+		return e, nil
+	}
+	body, err := ioutil.ReadAll(a.Body)
+	if err != nil {
+		return nil, err
+	}
+	e.Body = bytes.NewReader(body)
+	if !isASCII(string(body)) && utf8.Valid(body) {
+		if e.Header.Get("MIME-Version") == "" {
+			e.Header.Add("MIME-Version", "1.0")
+		}
+		e.Header.Add("Content-Type", "text/plain; charset=UTF-8")
+		if e.Header.Get("Content-Transfer-Encoding") == "" {
+			e.Header.Add("Content-Transfer-Encoding", "8bit")
+		}
+	}
+	return e, nil
+}
+
+// EncodeHeader returns value, the value of the header named key, as it
+// should be posted: if it has non-ASCII text, that text is encoded as RFC
+// 2047 encoded-words in UTF-8, and the value is folded so that the header
+// line fits in 78 columns. In address headers such as From, only display
+// names are encoded. Values that are plain ASCII, and the values of headers
+// such as Message-ID and Newsgroups that may only be ASCII, are returned as
+// they are.
+func EncodeHeader(key, value string) string {
+	if isASCII(value) || identifierHeaders[strings.ToLower(key)] {
+		return value
+	}
+	var words []headerWord
+	if addressHeaders[strings.ToLower(key)] {
+		if addrs, err := mail.ParseAddressList(value); err == nil {
+			words = addressWords(addrs)
+		}
+	}
+	if words == nil {
+		words = textWords(value)
+	}
+	return fold(len(key)+len(": "), words)
+}
+
+// A headerWord is a word of a header value being encoded: text to be
+// written as it is, or text to be written as one or more encoded-words.
+type headerWord struct {
+	text   string
+	encode bool
+}
+
+// textWords splits unstructured text into words, marking each run of words
+// that have non-ASCII text, or that could be taken for encoded-words, to be
+// encoded together.
+func textWords(s string) []headerWord {
+	var words []headerWord
+	for _, w := range strings.Fields(s) {
+		encode := !isASCII(w) || strings.Contains(w, "=?")
+		if n := len(words); encode && n > 0 && words[n-1].encode {
+			words[n-1].text += " " + w
+		} else {
+			words = append(words, headerWord{w, encode})
+		}
+	}
+	return words
+}
+
+// addressWords splits a list of addresses into words, marking the display
+// names that have non-ASCII text to be encoded.
+func addressWords(addrs []*mail.Address) []headerWord {
+	var words []headerWord
+	for i, addr := range addrs {
+		var ws []headerWord
+		if isASCII(addr.Name) {
+			for _, w := range strings.Fields(addr.String()) {
+				ws = append(ws, headerWord{w, false})
+			}
+		} else {
+			ws = []headerWord{{addr.Name, true}, {"<" + addr.Address + ">", false}}
+		}
+		if i < len(addrs)-1 {
+			ws[len(ws)-1].text += ","
+		}
+		words = append(words, ws...)
+	}
+	return words
+}
+
+const (
+	// maxEncodedWord is the longest an encoded-word may be (RFC 2047
+	// section 2).
+	maxEncodedWord = 75
+	// minEncodedWord is enough room for an encoded-word of any one
+	// character.
+	minEncodedWord = len("=?UTF-8?Q?=F0=9F=98=80?=")
+)
+
+// fold joins words with spaces, encoding those marked to be encoded and
+// starting new lines as needed to keep them within maxHeaderLine columns.
+// first is the length of the line before the first word.
+func fold(first int, words []headerWord) string {
+	var sb strings.Builder
+	col := first
+	space := func(need int) {
+		if sb.Len() == 0 {
+			return
+		}
+		if col+1+need > maxHeaderLine {
+			sb.WriteString("\n")
+			col = 0
+		}
+		sb.WriteString(" ")
+		col++
+	}
+	for _, w := range words {
+		if !w.encode {
+			space(len(w.text))
+			sb.WriteString(w.text)
+			col += len(w.text)
+			continue
+		}
+		// Runs are split into as many encoded-words as it takes; the
+		// spaces between them are not part of the text.
+		useB := len(bEncode(w.text)) < len(qEncode(w.text))
+		for rest := w.text; rest != ""; {
+			space(minEncodedWord)
+			limit := maxHeaderLine - col
+			if limit > maxEncodedWord {
+				limit = maxEncodedWord
+			}
+			word, n := encodedWord(rest, limit, useB)
+			sb.WriteString(word)
+			col += len(word)
+			rest = rest[n:]
+		}
+	}
+	return sb.String()
+}
+
+// encodedWord encodes as much of the start of s as fits in an encoded-word
+// of at most limit bytes, always taking at least one character. It returns
+// the word and the number of bytes of s it encodes.
+func encodedWord(s string, limit int, useB bool) (string, int) {
+	prefix := "=?UTF-8?Q?"
+	if useB {
+		prefix = "=?UTF-8?B?"
+	}
+	n := 0
+	for n < len(s) {
+		_, size := utf8.DecodeRuneInString(s[n:])
+		var l int
+		if useB {
+			l = len(bEncode(s[:n+size]))
+		} else {
+			l = len(qEncode(s[:n+size]))
+		}
+		if n > 0 && len(prefix)+l+len("?=") > limit {
+			break
+		}
+		n += size
+	}
+	if useB {
+		return prefix + bEncode(s[:n]) + "?=", n
+	}
+	return prefix + qEncode(s[:n]) + "?=", n
+}
+
+// bEncode returns s in the B encoding.
+func bEncode(s string) string {
+	return base64.StdEncoding.EncodeToString([]byte(s))
+}
+
+// qEncode returns s in the Q encoding, leaving only the characters that
+// may appear in an encoded-word in a phrase (RFC 2047 section 5) as they
+// are.
+func qEncode(s string) string {
+	var sb strings.Builder
+	for i := 0; i < len(s); i++ {
+		switch c := s[i]; {
+		case c == ' ':
+			sb.WriteByte('_')
+		case 'a' <= c && c <= 'z', 'A' <= c && c <= 'Z', '0' <= c && c <= '9',
+			c == '!', c == '*', c == '+', c == '-', c == '/':
+			sb.WriteByte(c)
+		default:
+			fmt.Fprintf(&sb, "=%02X", c)
+		}
+	}
+	return sb.String()
+}
+
+func isASCII(s string) bool {
+	for i := 0; i < len(s); i++ {
+		if s[i] >= utf8.RuneSelf {
+			return false
+		}
+	}
+	return true
+}
@@ -0,0 +1,119 @@
+package nntp
+
+import (
+	"bufio"
+	"bytes"
+	"strings"
+	"testing"
+)
+
+func TestDecodeHeader(t *testing.T) {
+	tests := []struct{ in, out string }{
+		{"plain subject", "plain subject"},
+		{"=?UTF-8?B?w6lww6ll?=", "épée"},
+		{"=?utf-8?q?caf=C3=A9_au_lait?=", "café au lait"},
+		{"=?ISO-8859-1?Q?Andr=E9?= <andre@example.com>", "André <andre@example.com>"},
+		{"=?iso-8859-2?Q?=B1?= =?iso-8859-15?Q?=A4?=", "ą€"},
+		{"=?windows-1251?B?z/Do4uXy?=", "Привет"},
+		{"=?KOI8-R?B?8NLJ18XU?=", "Привет"},
+		{"=?cp1252?Q?=93quoted=94?=", "“quoted”"},
+		{"caf\xe9", "café"},
+		{"=?UTF-8?B?bm90IGJhc2U2NA?", "=?UTF-8?B?bm90IGJhc2U2NA?"},
+	}
+	for _, tt := range tests {
+		if out := DecodeHeader(tt.in); out != tt.out {
+			t.Errorf("DecodeHeader(%q) = %q, expected %q", tt.in, out, tt.out)
+		}
+	}
+
+	d := HeaderDecoder{FallbackCharset: "koi8-r"}
+	if out, err := d.Decode("\xf0\xd2\xc9\xd7\xc5\xd4 =?utf-8?q?w=C3=B6rld?="); err != nil || out != "Привет wörld" {
+		t.Errorf("expected KOI8-R fallback to decode, got %q, %v", out, err)
+	}
+	if out, err := d.Decode("=?x-unknown?Q?abc?="); err == nil || out != "=?x-unknown?Q?abc?=" {
+		t.Errorf("expected an error for an unknown charset, got %q, %v", out, err)
+	}
+
+	ov := MessageOverview{Subject: "=?UTF-8?B?w6lww6ll?=", From: "=?ISO-8859-1?Q?Andr=E9?="}
+	if ov.DecodedSubject() != "épée" || ov.DecodedFrom() != "André" {
+		t.Errorf("unexpected decoded overview %q, %q", ov.DecodedSubject(), ov.DecodedFrom())
+	}
+	a := &Article{Header: Header{{"Subject", "=?UTF-8?B?w6lww6ll?="}}}
+	if a.DecodedSubject() != "épée" || a.DecodedFrom() != "" {
+		t.Errorf("unexpected decoded article %q, %q", a.DecodedSubject(), a.DecodedFrom())
+	}
+}
+
+func TestEncodeHeader(t *testing.T) {
+	if v := EncodeHeader("Subject", "plain =?old?= subject"); v != "plain =?old?= subject" {
+		t.Errorf("ASCII value changed to %q", v)
+	}
+	if v := EncodeHeader("Subject", "Привет, мир"); v != "=?UTF-8?B?0J/RgNC40LLQtdGCLCDQvNC40YA=?=" {
+		t.Errorf("expected Cyrillic to be B encoded, got %q", v)
+	}
+
+	subject := strings.Repeat("Ünïcödé sübjéct ", 8) + "with ASCII at the end"
+	v := EncodeHeader("Subject", subject)
+	for i, line := range strings.Split("Subject: "+v, "\n") {
+		if len(line) > 78 || !isASCII(line) || (i > 0 && line[0] != ' ') {
+			t.Errorf("bad header line %q", line)
+		}
+	}
+	if d := DecodeHeader(strings.Replace(v, "\n", "", -1)); d != subject {
+		t.Errorf("subject decoded to %q, expected %q", d, subject)
+	}
+
+	from := EncodeHeader("From", "Jörg Müller <joerg@example.com>, plain@example.com")
+	if from != "=?UTF-8?B?SsO2cmcgTcO8bGxlcg==?= <joerg@example.com>,\n <plain@example.com>" {
+		t.Errorf("unexpected From %q", from)
+	}
+	if d := DecodeHeader(strings.Replace(from, "\n", "", -1)); d != "Jörg Müller <joerg@example.com>, <plain@example.com>" {
+		t.Errorf("From decoded to %q", d)
+	}
+
+	if v := EncodeHeader("References", "<café@example.com>"); v != "<café@example.com>" {
+		t.Errorf("References encoded to %q", v)
+	}
+}
+
+func TestPostEncoded(t *testing.T) {
+	var cmdbuf bytes.Buffer
+	var fake faker
+	fake.Writer = &cmdbuf
+	server := strings.Repeat("340 Go ahead\r\n240 Article received OK\r\n", 2)
+	conn := &Conn{conn: fake, w: fake, r: bufio.NewReader(strings.NewReader(server))}
+
+	a := &Article{
+		Header: Header{{"From", "me@example.com"}, {"Newsgroups", "de.test"}, {"Subject", "Grüße"}},
+		Body:   strings.NewReader("Schöne Grüße.\n"),
+	}
+	if err := conn.Post(a); err != nil {
+		t.Fatal(err)
+	}
+	if a.Header.Get("Subject") != "Grüße" || len(a.Header) != 3 {
+		t.Fatalf("Post changed the article's header: %q", a.Header)
+	}
+	expected := "POST\r\n" +
+		"From: me@example.com\r\n" +
+		"Newsgroups: de.test\r\n" +
+		"Subject: =?UTF-8?B?R3LDvMOfZQ==?=\r\n" +
+		"MIME-Version: 1.0\r\n" +
+		"Content-Type: text/plain; charset=UTF-8\r\n" +
+		"Content-Transfer-Encoding: 8bit\r\n" +
+		"\r\n" +
+		"Schöne Grüße.\r\n" +
+		".\r\n"
+	if cmdbuf.String() != expected {
+		t.Fatalf("unexpected post:\n%s\nexpected:\n%s", cmdbuf.String(), expected)
+	}
+
+	// A body that isn't UTF-8 text is left without MIME headers.
+	cmdbuf.Reset()
+	a = &Article{Header: Header{{"From", "me@example.com"}, {"Newsgroups", "de.test"}, {"Subject", "bin"}}, Body: strings.NewReader("\xff\xfe\n")}
+	if err := conn.Post(a); err != nil {
+		t.Fatal(err)
+	}
+	if strings.Contains(cmdbuf.String(), "MIME-Version") {
+		t.Fatalf("unexpected MIME headers in post:\n%s", cmdbuf.String())
+	}
+}
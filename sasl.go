@@ -0,0 +1,183 @@
+package nntp
+
+import (
+	"crypto/hmac"
+	"crypto/md5"
+	"encoding/base64"
+	"errors"
+	"fmt"
+)
+
+// maxCommandLength is the longest command line, including the CRLF, that
+// RFC 3977 allows a client to send.
+const maxCommandLength = 512
+
+// A SASL is a SASL authentication mechanism, for use with
+// AuthenticateSASL.
+type SASL interface {
+	// Start begins an authentication exchange. It returns the name of
+	// the mechanism and the initial response to send to the server, or
+	// nil if the mechanism has no initial response.
+	Start() (mech string, ir []byte, err error)
+
+	// Next continues the exchange with data sent by the server. If more
+	// is true, the server is waiting for a response; otherwise it has
+	// accepted the authentication and sent additional data, which Next
+	// may check. If Next returns an error, the exchange is cancelled.
+	Next(challenge []byte, more bool) (response []byte, err error)
+}
+
+// AuthenticateSASL logs in to the NNTP server using AUTHINFO SASL, as
+// defined in RFC 4643, with the mechanism m.
+func (c *Conn) AuthenticateSASL(m SASL) error {
+	mech, ir, err := m.Start()
+	if err != nil {
+		return err
+	}
+
+	// An initial response that would make the command too long is sent
+	// in reply to the server's first, empty, challenge instead.
+	cmd := "AUTHINFO SASL " + mech
+	if ir != nil {
+		if arg := encodeSASL(ir); len(cmd)+len(arg)+3 <= maxCommandLength {
+			cmd += " " + arg
+			ir = nil
+		}
+	}
+
+	code, line, err := c.cmd(0, "%s", cmd)
+	for err == nil {
+		switch code {
+		case 281:
+			c.forgetCapabilities()
+			return nil
+		case 283:
+			var data []byte
+			if data, err = decodeSASL(line); err == nil {
+				_, err = m.Next(data, false)
+			}
+			if err == nil {
+				c.forgetCapabilities()
+			}
+			return err
+		case 383:
+			var resp []byte
+			if ir != nil {
+				resp, ir = ir, nil
+			} else {
+				var challenge []byte
+				if challenge, err = decodeSASL(line); err != nil {
+					err = c.annotate(err)
+				} else {
+					resp, err = m.Next(challenge, true)
+				}
+			}
+			if err != nil {
+				// Cancel the exchange; the server answers 481.
+				c.cmd(0, "*")
+				return err
+			}
+			if err = c.send("%s", encodeSASL(resp)); err != nil {
+				return err
+			}
+			// errors about the reply should name the exchange, not the data
+			c.lastCmd = "AUTHINFO SASL " + mech
+			code, line, err = c.response(0)
+		default:
+			return Error{code, line}
+		}
+	}
+	return err
+}
+
+// encodeSASL encodes data for an AUTHINFO SASL exchange.
+func encodeSASL(data []byte) string {
+	if len(data) == 0 {
+		return "="
+	}
+	return base64.StdEncoding.EncodeToString(data)
+}
+
+// decodeSASL decodes data sent by the server in an AUTHINFO SASL exchange.
+func decodeSASL(s string) ([]byte, error) {
+	if s == "" || s == "=" {
+		return []byte{}, nil
+	}
+	data, err := base64.StdEncoding.DecodeString(s)
+	if err != nil {
+		return nil, ProtocolError{Msg: "bad SASL data", Line: s}
+	}
+	return data, nil
+}
+
+var errUnexpectedChallenge = errors.New("unexpected SASL challenge from server")
+
+type plainAuth struct {
+	identity, username, password string
+}
+
+// PlainAuth returns a SASL that implements the PLAIN mechanism, as defined
+// in RFC 4616. identity is usually empty, to act as username.
+//
+// PLAIN sends the password in the clear, so it should only be used over
+// TLS.
+func PlainAuth(identity, username, password string) SASL {
+	return &plainAuth{identity, username, password}
+}
+
+func (a *plainAuth) Start() (string, []byte, error) {
+	return "PLAIN", []byte(a.identity + "\x00" + a.username + "\x00" + a.password), nil
+}
+
+func (a *plainAuth) Next(challenge []byte, more bool) ([]byte, error) {
+	if more {
+		return nil, errUnexpectedChallenge
+	}
+	return nil, nil
+}
+
+type cramMD5Auth struct {
+	username, secret string
+}
+
+// CRAMMD5Auth returns a SASL that implements the CRAM-MD5 mechanism, as
+// defined in RFC 2195.
+func CRAMMD5Auth(username, secret string) SASL {
+	return &cramMD5Auth{username, secret}
+}
+
+func (a *cramMD5Auth) Start() (string, []byte, error) {
+	return "CRAM-MD5", nil, nil
+}
+
+func (a *cramMD5Auth) Next(challenge []byte, more bool) ([]byte, error) {
+	if !more {
+		return nil, nil
+	}
+	d := hmac.New(md5.New, []byte(a.secret))
+	d.Write(challenge)
+	return []byte(fmt.Sprintf("%s %x", a.username, d.Sum(nil))), nil
+}
+
+type externalAuth struct {
+	identity string
+}
+
+// ExternalAuth returns a SASL that implements the EXTERNAL mechanism, as
+// defined in RFC 4422, which relies on credentials established outside
+// NNTP, such as a TLS client certificate. identity is usually empty, to
+// act as the identity those credentials establish.
+func ExternalAuth(identity string) SASL {
+	return &externalAuth{identity}
+}
+
+func (a *externalAuth) Start() (string, []byte, error) {
+	return "EXTERNAL", []byte(a.identity), nil
+}
+
+func (a *externalAuth) Next(challenge []byte, more bool) ([]byte, error) {
+	if more {
+		return nil, errUnexpectedChallenge
+	}
+	return nil, nil
+}
@@ -0,0 +1,61 @@
+package nntp
+
+import (
+	"bufio"
+	"bytes"
+	"encoding/base64"
+	"errors"
+	"strings"
+	"testing"
+)
+
+func TestAuthenticateSASL(t *testing.T) {
+	var cmdbuf bytes.Buffer
+	var fake faker
+	fake.Writer = &cmdbuf
+
+	conn := &Conn{conn: fake, w: fake, r: bufio.NewReader(strings.NewReader(saslServer))}
+
+	if err := conn.AuthenticateSASL(PlainAuth("", "user", "pass")); err != nil {
+		t.Fatal("PLAIN shouldn't error: " + err.Error())
+	}
+
+	// RFC 2195's example exchange
+	if err := conn.AuthenticateSASL(CRAMMD5Auth("tim", "tanstaaftanstaaf")); err != nil {
+		t.Fatal("CRAM-MD5 shouldn't error: " + err.Error())
+	}
+
+	if err := conn.AuthenticateSASL(ExternalAuth("")); ErrorCode(err) != 481 {
+		t.Fatalf("EXTERNAL should fail with 481, got %v", err)
+	}
+
+	if err := conn.AuthenticateSASL(PlainAuth("", strings.Repeat("u", 500), "pass")); err != nil {
+		t.Fatal("PLAIN with a long initial response shouldn't error: " + err.Error())
+	}
+
+	if err := conn.AuthenticateSASL(PlainAuth("", "user", "pass")); !errors.Is(err, errUnexpectedChallenge) {
+		t.Fatalf("PLAIN should reject a challenge, got %v", err)
+	}
+
+	if actualcmds := cmdbuf.String(); actualcmds != saslClient {
+		t.Fatalf("Got:\n%s\nExpected\n%s", actualcmds, saslClient)
+	}
+}
+
+var saslServer = "281 Authentication accepted\r\n" +
+	"383 PDE4OTYuNjk3MTcwOTUyQHBvc3RvZmZpY2UucmVzdG9uLm1jaS5uZXQ+\r\n" +
+	"281 Authentication accepted\r\n" +
+	"481 Authentication failed\r\n" +
+	"383 =\r\n" +
+	"281 Authentication accepted\r\n" +
+	"383 Zm9v\r\n" +
+	"481 Authentication cancelled\r\n"
+
+var saslClient = "AUTHINFO SASL PLAIN AHVzZXIAcGFzcw==\r\n" +
+	"AUTHINFO SASL CRAM-MD5\r\n" +
+	"dGltIGI5MTNhNjAyYzdlZGE3YTQ5NWI0ZTZlNzMzNGQzODkw\r\n" +
+	"AUTHINFO SASL EXTERNAL =\r\n" +
+	"AUTHINFO SASL PLAIN\r\n" +
+	base64.StdEncoding.EncodeToString([]byte("\x00"+strings.Repeat("u", 500)+"\x00pass")) + "\r\n" +
+	"AUTHINFO SASL PLAIN AHVzZXIAcGFzcw==\r\n" +
+	"*\r\n"
@@ -0,0 +1,215 @@
+package nntp
+
+import (
+	"errors"
+	"fmt"
+	"io"
+	"strings"
+)
+
+// A TransferStatus is a peer's answer to an article being offered or sent
+// to it.
+type TransferStatus int
+
+const (
+	// The peer wants the article (CHECK 238, IHAVE 335).
+	TransferWanted TransferStatus = iota
+	// The peer accepted the article (TAKETHIS 239, IHAVE 235).
+	TransferAccepted
+	// The peer already has the article or doesn't want it
+	// (CHECK 438, IHAVE 435).
+	TransferNotWanted
+	// The peer can't take the article right now, but it may be offered
+	// again later (CHECK 431, IHAVE 436).
+	TransferDeferred
+	// The peer rejected the article and it should not be offered again
+	// (TAKETHIS 439, IHAVE 437).
+	TransferRejected
+)
+
+var transferStatusNames = []string{
+	TransferWanted:    "wanted",
+	TransferAccepted:  "accepted",
+	TransferNotWanted: "not wanted",
+	TransferDeferred:  "deferred",
+	TransferRejected:  "rejected",
+}
+
+func (s TransferStatus) String() string {
+	if s < 0 || int(s) >= len(transferStatusNames) {
+		return fmt.Sprintf("TransferStatus(%d)", int(s))
+	}
+	return transferStatusNames[s]
+}
+
+// streamStatus maps the response codes of the streaming commands to
+// outcomes.
+var streamStatus = map[string]map[uint]TransferStatus{
+	"CHECK":    {238: TransferWanted, 431: TransferDeferred, 438: TransferNotWanted},
+	"TAKETHIS": {239: TransferAccepted, 439: TransferRejected},
+}
+
+// IHave offers the article with the given message-id to the server with
+// the IHAVE command, sending it, read in text format from r, if the server
+// wants it. The returned status is TransferAccepted if the server took the
+// article; TransferNotWanted, TransferDeferred and TransferRejected report
+// why it did not. Other responses are returned as errors.
+func (c *Conn) IHave(msgid string, r io.Reader) (TransferStatus, error) {
+	code, line, err := c.cmd(0, "IHAVE %s", msgid)
+	if err != nil {
+		return 0, err
+	}
+	switch code {
+	case 335:
+	case 435:
+		return TransferNotWanted, nil
+	case 436:
+		return TransferDeferred, nil
+	default:
+		return 0, Error{code, line}
+	}
+
+	if err := c.writeDotted(r); err != nil {
+		return 0, err
+	}
+	if code, line, err = c.response(0); err != nil {
+		return 0, err
+	}
+	switch code {
+	case 235:
+		return TransferAccepted, nil
+	case 436:
+		return TransferDeferred, nil
+	case 437:
+		return TransferRejected, nil
+	default:
+		return 0, Error{code, line}
+	}
+}
+
+// IHaveArticle offers an article to the server with IHAVE, as IHave does,
+// taking the message-id from the article's Message-Id header.
+func (c *Conn) IHaveArticle(a *Article) (TransferStatus, error) {
+	id := a.Header.Get("Message-Id")
+	if id == "" {
+		return 0, errors.New("article has no Message-Id header")
+	}
+	return c.IHave(id, &articleReader{a: a})
+}
+
+// A StreamResult is the peer's response to a CHECK or TAKETHIS command.
+type StreamResult struct {
+	Command   string // "CHECK" or "TAKETHIS"
+	MessageID string
+	Status    TransferStatus
+	Code      uint   // The response code
+	Msg       string // Any text following the message-id in the response
+}
+
+// A Streamer feeds articles to a peer with the streaming commands CHECK
+// and TAKETHIS, as defined in RFC 4644. Commands are pipelined: up to
+// window commands are sent before waiting for the oldest response, and
+// responses are passed to a callback as they arrive.
+//
+// While a Streamer is in use, no other methods of its Conn may be called.
+type Streamer struct {
+	c       *Conn
+	window  int
+	result  func(StreamResult)
+	pending []StreamResult // commands awaiting responses, oldest first
+	err     error
+}
+
+// ModeStream asks the server to allow the streaming commands.
+func (c *Conn) ModeStream() error {
+	_, _, err := c.cmd(203, "MODE STREAM")
+	return err
+}
+
+// Stream switches the server to streaming mode and returns a Streamer that
+// keeps up to window commands in flight, calling result with the peer's
+// response to each of them in order.
+func (c *Conn) Stream(window int, result func(StreamResult)) (*Streamer, error) {
+	if err := c.ModeStream(); err != nil {
+		return nil, err
+	}
+	if window < 1 {
+		window = 1
+	}
+	return &Streamer{c: c, window: window, result: result}, nil
+}
+
+// Check asks the peer whether it wants the article with the given
+// message-id.
+func (s *Streamer) Check(msgid string) error {
+	return s.do("CHECK", msgid, nil)
+}
+
+// TakeThis sends the article with the given message-id, read in text
+// format from r, to the peer.
+func (s *Streamer) TakeThis(msgid string, r io.Reader) error {
+	return s.do("TAKETHIS", msgid, r)
+}
+
+// Flush waits for the responses to all commands in flight.
+func (s *Streamer) Flush() error {
+	for s.err == nil && len(s.pending) > 0 {
+		s.readResult()
+	}
+	return s.err
+}
+
+func (s *Streamer) do(cmd, msgid string, r io.Reader) error {
+	if s.err != nil {
+		return s.err
+	}
+
+	if s.err = s.c.send("%s %s", cmd, msgid); s.err != nil {
+		return s.err
+	}
+	if r != nil {
+		if s.err = s.c.writeDotted(r); s.err != nil {
+			return s.err
+		}
+	}
+	s.pending = append(s.pending, StreamResult{Command: cmd, MessageID: msgid})
+
+	for s.err == nil && len(s.pending) >= s.window {
+		s.readResult()
+	}
+	return s.err
+}
+
+// readResult reads the response to the oldest command in flight. Errors
+// are sticky, as there is no telling which response comes next.
+func (s *Streamer) readResult() {
+	res := s.pending[0]
+	cmd := res.Command + " " + res.MessageID
+	code, line, err := s.c.response(0)
+	if err != nil {
+		s.err = inResponseTo(err, cmd)
+		return
+	}
+
+	status, ok := streamStatus[res.Command][code]
+	if !ok {
+		// Responses to the other commands in flight may follow; there is
+		// no getting back in step with them.
+		s.err = s.c.fail(Error{code, line})
+		return
+	}
+	ss := strings.SplitN(line, " ", 2)
+	if ss[0] != res.MessageID {
+		s.err = s.c.fail(ProtocolError{Msg: "response is for another article", Line: line, Command: cmd})
+		return
+	}
+
+	res.Status, res.Code = status, code
+	if len(ss) > 1 {
+		res.Msg = ss[1]
+	}
+	s.pending = s.pending[1:]
+	if s.result != nil {
+		s.result(res)
+	}
+}
@@ -0,0 +1,178 @@
+package nntp
+
+import (
+	"bufio"
+	"bytes"
+	"io/ioutil"
+	"strings"
+	"testing"
+)
+
+func TestStreamer(t *testing.T) {
+	var cmdbuf bytes.Buffer
+	var fake faker
+	fake.Writer = &cmdbuf
+
+	conn := &Conn{conn: fake, w: fake, r: bufio.NewReader(strings.NewReader(streamServer))}
+
+	var results []StreamResult
+	s, err := conn.Stream(2, func(res StreamResult) {
+		results = append(results, res)
+	})
+	if err != nil {
+		t.Fatal("MODE STREAM shouldn't error: " + err.Error())
+	}
+
+	if err := s.Check("<a@b.c>"); err != nil {
+		t.Fatal("CHECK shouldn't error: " + err.Error())
+	}
+	if err := s.Check("<b@c.d>"); err != nil {
+		t.Fatal("CHECK shouldn't error: " + err.Error())
+	}
+	if len(results) != 1 || results[0].Status != TransferWanted {
+		t.Fatalf("expected the first CHECK to be answered once the window filled, got %+v", results)
+	}
+
+	article := "Message-ID: <a@b.c>\n\n.leading dot\n"
+	if err := s.TakeThis("<a@b.c>", strings.NewReader(article)); err != nil {
+		t.Fatal("TAKETHIS shouldn't error: " + err.Error())
+	}
+	if err := s.Flush(); err != nil {
+		t.Fatal("Flush shouldn't error: " + err.Error())
+	}
+
+	expected := []StreamResult{
+		{"CHECK", "<a@b.c>", TransferWanted, 238, ""},
+		{"CHECK", "<b@c.d>", TransferNotWanted, 438, "already have it"},
+		{"TAKETHIS", "<a@b.c>", TransferAccepted, 239, ""},
+	}
+	if len(results) != len(expected) {
+		t.Fatalf("expected %+v, got %+v", expected, results)
+	}
+	for i := range results {
+		if results[i] != expected[i] {
+			t.Fatalf("expected %+v, got %+v", expected, results)
+		}
+	}
+
+	if err := s.Check("<c@d.e>"); err != nil {
+		t.Fatal("CHECK shouldn't error: " + err.Error())
+	}
+	if err := s.Flush(); !IsProtocol(err) {
+		t.Fatalf("a response for the wrong message-id should be a protocol error, got %v", err)
+	}
+
+	if actualcmds := cmdbuf.String(); actualcmds != streamClient {
+		t.Fatalf("Got:\n%s\nExpected\n%s", actualcmds, streamClient)
+	}
+}
+
+func TestStreamerUnexpected(t *testing.T) {
+	var fake faker
+	fake.Writer = ioutil.Discard
+	server := "203 Streaming permitted\r\n" +
+		"500 What?\r\n" +
+		"238 <b@c.d>\r\n"
+	conn := &Conn{conn: fake, w: fake, r: bufio.NewReader(strings.NewReader(server))}
+
+	s, err := conn.Stream(2, nil)
+	if err != nil {
+		t.Fatal("MODE STREAM shouldn't error: " + err.Error())
+	}
+	s.Check("<a@b.c>")
+	s.Check("<b@c.d>")
+	if err := s.Flush(); ErrorCode(err) != 500 {
+		t.Fatalf("expected the 500 response as an error, got %v", err)
+	}
+	if !conn.close {
+		t.Fatal("an unexpected response should close the connection")
+	}
+
+	// Errors name the command the response was to, not the last one sent.
+	server = "203 Streaming permitted\r\n" +
+		"238 <b@c.d>\r\n"
+	conn = &Conn{conn: fake, w: fake, r: bufio.NewReader(strings.NewReader(server))}
+	if s, err = conn.Stream(2, nil); err != nil {
+		t.Fatal("MODE STREAM shouldn't error: " + err.Error())
+	}
+	s.Check("<a@b.c>")
+	s.Check("<b@c.d>")
+	if perr, ok := s.Flush().(ProtocolError); !ok || perr.Command != "CHECK <a@b.c>" {
+		t.Fatalf("expected a protocol error in response to CHECK <a@b.c>, got %v", perr)
+	}
+}
+
+var streamServer = "203 Streaming permitted\r\n" +
+	"238 <a@b.c>\r\n" +
+	"438 <b@c.d> already have it\r\n" +
+	"239 <a@b.c>\r\n" +
+	"238 <d@e.f>\r\n"
+
+var streamClient = "MODE STREAM\r\n" +
+	"CHECK <a@b.c>\r\n" +
+	"CHECK <b@c.d>\r\n" +
+	"TAKETHIS <a@b.c>\r\n" +
+	"Message-ID: <a@b.c>\r\n" +
+	"\r\n" +
+	"..leading dot\r\n" +
+	".\r\n" +
+	"CHECK <c@d.e>\r\n"
+
+func TestIHave(t *testing.T) {
+	var cmdbuf bytes.Buffer
+	var fake faker
+	fake.Writer = &cmdbuf
+
+	conn := &Conn{conn: fake, w: fake, r: bufio.NewReader(strings.NewReader(ihaveServer))}
+
+	a := &Article{
+		Header: Header{{"Message-Id", "<a@b.c>"}},
+		Body:   strings.NewReader("Body.\n"),
+	}
+	expected := []TransferStatus{TransferAccepted, TransferNotWanted, TransferDeferred, TransferRejected}
+	for i, want := range expected {
+		var status TransferStatus
+		var err error
+		if i == 0 {
+			status, err = conn.IHaveArticle(a)
+		} else {
+			status, err = conn.IHave("<a@b.c>", strings.NewReader("Message-ID: <a@b.c>\n\nBody.\n"))
+		}
+		if err != nil {
+			t.Fatalf("IHAVE #%d shouldn't error: %v", i, err)
+		}
+		if status != want {
+			t.Fatalf("IHAVE #%d should be %v, got %v", i, want, status)
+		}
+	}
+
+	if _, err := conn.IHave("<a@b.c>", strings.NewReader("")); ErrorCode(err) != 480 {
+		t.Fatalf("expected a 480 error, got %v", err)
+	}
+
+	if actualcmds := cmdbuf.String(); actualcmds != ihaveClient {
+		t.Fatalf("Got:\n%s\nExpected\n%s", actualcmds, ihaveClient)
+	}
+}
+
+var ihaveServer = "335 Send it\r\n" +
+	"235 Article transferred OK\r\n" +
+	"435 Article not wanted\r\n" +
+	"436 Transfer not possible; try again later\r\n" +
+	"335 Send it\r\n" +
+	"437 Transfer rejected; do not retry\r\n" +
+	"480 Transfer permission denied\r\n"
+
+var ihaveClient = "IHAVE <a@b.c>\r\n" +
+	"Message-Id: <a@b.c>\r\n" +
+	"\r\n" +
+	"Body.\r\n" +
+	".\r\n" +
+	"IHAVE <a@b.c>\r\n" +
+	"IHAVE <a@b.c>\r\n" +
+	"IHAVE <a@b.c>\r\n" +
+	"Message-ID: <a@b.c>\r\n" +
+	"\r\n" +
+	"Body.\r\n" +
+	".\r\n" +
+	"IHAVE <a@b.c>\r\n"
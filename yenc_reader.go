@@ -4,19 +4,188 @@ import (
 	"bufio"
 	"bytes"
 	"fmt"
+	"hash"
+	"hash/crc32"
 	"io"
+	"strconv"
+	"strings"
 )
 
-type yencReader struct {
+// A YencHeader holds what the =ybegin, =ypart and =yend lines of yEnc data
+// say about it.
+type YencHeader struct {
+	Name  string // File name
+	Size  int64  // Size of the whole file, or -1 if unknown
+	Line  int    // Typical length of an encoded line
+	Part  int    // Part number, from 1, or 0 if the file is in one part
+	Total int    // Number of parts, or 0 if not given
+	Begin int64  // Offset of the part's first byte in the file, from 1
+	End   int64  // Offset of the part's last byte in the file
+
+	// These come from the =yend line, and are only known once the data
+	// has been read. They are zero if the line doesn't give them.
+	PartSize  int64  // Size of the part
+	PartCRC32 uint32 // CRC32 of the part
+	CRC32     uint32 // CRC32 of the whole file
+}
+
+// A YencError reports yEnc data that is malformed, or that doesn't match
+// the size or CRC32 given for it.
+type YencError struct {
+	Msg  string // What's wrong
+	Line string // The offending line, if any
+}
+
+func (e YencError) Error() string {
+	if e.Line == "" {
+		return "yenc: " + e.Msg
+	}
+	return fmt.Sprintf("yenc: %s: %+q", e.Msg, e.Line)
+}
+
+// A YencDecoder reads yEnc encoded data, as found in the bodies of binary
+// articles, and decodes it.
+//
+// Once all the data has been read, the decoder checks its size and CRC32
+// against the =yend line, and returns a YencError instead of io.EOF if they
+// don't match. For a part of a multipart file only the part's CRC32 can
+// be checked; the CRC32 of the whole file is left to the caller.
+type YencDecoder struct {
+	Header YencHeader
+
 	r               *bufio.Reader
-	readHeader      bool
 	awaitingSpecial bool
 	eof             bool
+	err             error
 	buf             []byte
+	n               int64
+	crc             hash.Hash32
+}
+
+// NewYencDecoder returns a decoder for the yEnc data read from r. Lines
+// before the =ybegin line are skipped. The decoder reads no further than
+// the =yend line if r is a *bufio.Reader.
+func NewYencDecoder(r io.Reader) (*YencDecoder, error) {
+	br, ok := r.(*bufio.Reader)
+	if !ok {
+		br = bufio.NewReader(r)
+	}
+	y := &YencDecoder{r: br, crc: crc32.NewIEEE()}
+	if err := y.readHeader(); err != nil {
+		return nil, err
+	}
+	return y, nil
+}
+
+// readLine reads a line without its line ending.
+func (y *YencDecoder) readLine() ([]byte, error) {
+	line, err := y.r.ReadBytes('\n')
+	if err == io.EOF && len(line) > 0 {
+		err = nil
+	}
+	return bytes.TrimRight(line, "\r\n"), err
+}
+
+func (y *YencDecoder) readHeader() error {
+	var line []byte
+	var err error
+	for {
+		if line, err = y.readLine(); err == io.EOF {
+			return YencError{Msg: "no =ybegin line"}
+		} else if err != nil {
+			return err
+		}
+		if bytes.HasPrefix(line, []byte("=ybegin ")) {
+			break
+		}
+	}
+
+	h := &y.Header
+	h.Size = -1
+	fields := yencFields(string(line))
+	h.Name = fields["name"]
+	if err := yencInts(string(line), fields, map[string]interface{}{
+		"size":  &h.Size,
+		"line":  &h.Line,
+		"part":  &h.Part,
+		"total": &h.Total,
+	}); err != nil {
+		return err
+	}
+
+	if h.Part == 0 {
+		if h.Size >= 0 {
+			h.Begin, h.End = 1, h.Size
+		}
+		return nil
+	}
+
+	if line, err = y.readLine(); err == io.EOF {
+		return YencError{Msg: "no =ypart line"}
+	} else if err != nil {
+		return err
+	}
+	if !bytes.HasPrefix(line, []byte("=ypart ")) {
+		return YencError{"expected =ypart", string(line)}
+	}
+	fields = yencFields(string(line))
+	if _, ok := fields["begin"]; !ok {
+		return YencError{"=ypart without begin", string(line)}
+	}
+	if _, ok := fields["end"]; !ok {
+		return YencError{"=ypart without end", string(line)}
+	}
+	return yencInts(string(line), fields, map[string]interface{}{
+		"begin": &h.Begin,
+		"end":   &h.End,
+	})
 }
 
-func (y *yencReader) Read(p []byte) (n int, err error) {
-	if len(y.buf) == 0 {
+// yencFields splits a =y line into its keyword=value fields. The name
+// field is always last and runs to the end of the line, spaces and all.
+func yencFields(line string) map[string]string {
+	fields := make(map[string]string)
+	if i := strings.Index(line, " name="); i >= 0 {
+		fields["name"] = strings.TrimSpace(line[i+len(" name="):])
+		line = line[:i]
+	}
+	for _, f := range strings.Fields(line)[1:] {
+		if i := strings.IndexByte(f, '='); i > 0 {
+			fields[f[:i]] = f[i+1:]
+		}
+	}
+	return fields
+}
+
+// yencInts parses the numeric fields named in dst, which holds pointers
+// to int, int64 or uint32 (hexadecimal, for CRCs). Missing fields are left
+// alone.
+func yencInts(line string, fields map[string]string, dst map[string]interface{}) error {
+	for key, p := range dst {
+		s, ok := fields[key]
+		if !ok {
+			continue
+		}
+		var err error
+		switch p := p.(type) {
+		case *int:
+			*p, err = strconv.Atoi(s)
+		case *int64:
+			*p, err = strconv.ParseInt(s, 10, 64)
+		case *uint32:
+			var v uint64
+			v, err = strconv.ParseUint(s, 16, 32)
+			*p = uint32(v)
+		}
+		if err != nil {
+			return YencError{fmt.Sprintf("bad %s", key), line}
+		}
+	}
+	return nil
+}
+
+func (y *YencDecoder) Read(p []byte) (n int, err error) {
+	for len(y.buf) == 0 {
 		if err = y.nextLine(); err != nil {
 			return
 		}
@@ -27,53 +196,74 @@ func (y *yencReader) Read(p []byte) (n int, err error) {
 	return
 }
 
-func (y *yencReader) Close() {
-	// read until EOF
+// Close reads and checks the rest of the data, so that the underlying
+// reader is left after the =yend line.
+func (y *YencDecoder) Close() error {
 	for {
-		if y.eof {
-			break
+		if err := y.nextLine(); err == io.EOF {
+			return nil
+		} else if err != nil {
+			return err
 		}
-		
-		y.nextLine()
 	}
 }
 
-func (y *yencReader) nextLine() error {
+func (y *YencDecoder) nextLine() error {
 	if y.eof {
-		return io.EOF
+		return y.err
 	}
 
-	// read a whole line
-	line, err := y.r.ReadBytes('\n')
-	if err != nil {
+	line, err := y.readLine()
+	if err == io.EOF {
+		return YencError{Msg: "no =yend line"}
+	} else if err != nil {
 		return err
 	}
 
-	// chomp the line ending
-	line = bytes.TrimRight(line, "\r\n")
-
-	if !y.readHeader {
-		// expect a =ybegin line
-		if len(line) >= 7 && string(line[:7]) == "=ybegin" {
-			y.readHeader = true
-			return nil
-		} else {
-			return fmt.Errorf("expected =ybegin, got %q", string(line))
-		}
-	}
-
 	// are we at the end of the yenc blob?
-	if len(line) >= 5 && string(line[:5]) == "=yend" {
+	if bytes.HasPrefix(line, []byte("=yend")) {
 		// remember this and signal the caller
 		y.eof = true
-		return io.EOF
+		y.err = y.verify(string(line))
+		return y.err
 	}
 
 	y.buf = y.decode(line)
+	y.n += int64(len(y.buf))
+	y.crc.Write(y.buf)
 	return nil
 }
 
-func (y *yencReader) decode(line []byte) []byte {
+// verify checks the decoded data against the =yend line.
+func (y *YencDecoder) verify(line string) error {
+	h := &y.Header
+	fields := yencFields(line)
+	if err := yencInts(line, fields, map[string]interface{}{
+		"size":   &h.PartSize,
+		"pcrc32": &h.PartCRC32,
+		"crc32":  &h.CRC32,
+	}); err != nil {
+		return err
+	}
+
+	if _, ok := fields["size"]; ok && h.PartSize != y.n {
+		return YencError{Msg: fmt.Sprintf("decoded %d bytes, but =yend says %d", y.n, h.PartSize)}
+	}
+	if h.End > 0 && h.End-h.Begin+1 != y.n {
+		return YencError{Msg: fmt.Sprintf("decoded %d bytes, but the header says %d", y.n, h.End-h.Begin+1)}
+	}
+
+	crc := y.crc.Sum32()
+	if _, ok := fields["pcrc32"]; ok && h.PartCRC32 != crc {
+		return YencError{Msg: fmt.Sprintf("pcrc32 mismatch: =yend says %08x, data has %08x", h.PartCRC32, crc)}
+	}
+	if _, ok := fields["crc32"]; ok && h.Part == 0 && h.CRC32 != crc {
+		return YencError{Msg: fmt.Sprintf("crc32 mismatch: =yend says %08x, data has %08x", h.CRC32, crc)}
+	}
+	return io.EOF
+}
+
+func (y *YencDecoder) decode(line []byte) []byte {
 	i, j := 0, 0
 	for ; i < len(line); i, j = i+1, j+1 {
 		// escaped chars yenc42+yenc64
@@ -0,0 +1,191 @@
+package nntp
+
+import (
+	"bufio"
+	"bytes"
+	"errors"
+	"fmt"
+	"io/ioutil"
+	"strings"
+	"testing"
+)
+
+// yencHello is "hello" and then 19, 0, 214, 1, which need escaping.
+const yencHello = "\x92\x8f\x96\x96\x99\r\n" +
+	"=\x7d\x2a=\x40\x2b\r\n"
+
+func TestYencDecoder(t *testing.T) {
+	body := "Some text before the data\r\n" +
+		"=ybegin line=128 size=9 name=hello world.bin\r\n" +
+		yencHello +
+		"=yend size=9 crc32=53323a9f\r\n" +
+		"after\r\n"
+	r := bufio.NewReader(strings.NewReader(body))
+	y, err := NewYencDecoder(r)
+	if err != nil {
+		t.Fatal(err)
+	}
+	data, err := ioutil.ReadAll(y)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if string(data) != "hello\x13\x00\xd6\x01" {
+		t.Fatalf("unexpected data %q", data)
+	}
+	expected := YencHeader{Name: "hello world.bin", Size: 9, Line: 128, Begin: 1, End: 9, PartSize: 9, CRC32: 0x53323a9f}
+	if y.Header != expected {
+		t.Fatalf("expected header %+v, got %+v", expected, y.Header)
+	}
+	if rest, _ := r.ReadString('\n'); rest != "after\r\n" {
+		t.Fatalf("expected the decoder to stop after =yend, got %q", rest)
+	}
+}
+
+func TestYencDecoderPart(t *testing.T) {
+	body := "=ybegin part=2 total=3 line=128 size=100 name=hello.bin\r\n" +
+		"=ypart begin=11 end=19\r\n" +
+		yencHello +
+		"=yend size=9 part=2 pcrc32=53323a9f crc32=12345678\r\n"
+	y, err := NewYencDecoder(strings.NewReader(body))
+	if err != nil {
+		t.Fatal(err)
+	}
+	if err := y.Close(); err != nil {
+		t.Fatal("Close shouldn't error: " + err.Error())
+	}
+	h := y.Header
+	if h.Part != 2 || h.Total != 3 || h.Begin != 11 || h.End != 19 || h.PartCRC32 != 0x53323a9f || h.CRC32 != 0x12345678 {
+		t.Fatalf("unexpected header %+v", h)
+	}
+}
+
+func TestYencDecoderMismatch(t *testing.T) {
+	for _, trailer := range []string{
+		"=yend size=9 crc32=53323a9e",
+		"=yend size=10 crc32=53323a9f",
+	} {
+		body := "=ybegin line=128 size=9 name=hello.bin\r\n" + yencHello + trailer + "\r\n"
+		y, err := NewYencDecoder(strings.NewReader(body))
+		if err != nil {
+			t.Fatal(err)
+		}
+		_, err = ioutil.ReadAll(y)
+		var yerr YencError
+		if !errors.As(err, &yerr) {
+			t.Fatalf("expected a YencError for %q, got %v", trailer, err)
+		}
+	}
+
+	if _, err := NewYencDecoder(strings.NewReader("no yenc here\r\n")); err == nil {
+		t.Fatal("expected an error without =ybegin")
+	}
+}
+
+func TestYencEncoder(t *testing.T) {
+	// Every byte value, and bytes that encode to a space, a tab and a dot
+	// at the start and end of the data, where they must be escaped.
+	data := []byte{' ' + 256 - 42, '\t' + 256 - 42, '.' - 42}
+	for i := 0; i < 256; i++ {
+		data = append(data, byte(i), byte(i))
+	}
+	data = append(data, '\t'+256-42, ' '+256-42)
+
+	var buf bytes.Buffer
+	y := NewYencEncoder(&buf, YencHeader{Name: "all bytes.bin", Size: int64(len(data)), Line: 16})
+	if _, err := y.Write(data[:100]); err != nil {
+		t.Fatal(err)
+	}
+	if _, err := y.Write(data[100:]); err != nil {
+		t.Fatal(err)
+	}
+	if err := y.Close(); err != nil {
+		t.Fatal(err)
+	}
+
+	for _, line := range strings.Split(strings.TrimSuffix(buf.String(), "\r\n"), "\r\n") {
+		if strings.HasPrefix(line, "=y") {
+			continue
+		}
+		if strings.ContainsAny(line, "\x00\r\n") || strings.HasPrefix(line, ".") ||
+			strings.Trim(line, " \t") != line || len(line) > 17 {
+			t.Fatalf("badly encoded line %q", line)
+		}
+	}
+
+	d, err := NewYencDecoder(&buf)
+	if err != nil {
+		t.Fatal(err)
+	}
+	decoded, err := ioutil.ReadAll(d)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if !bytes.Equal(decoded, data) {
+		t.Fatalf("expected %q, got %q", data, decoded)
+	}
+	if d.Header.Name != "all bytes.bin" || d.Header.Line != 16 {
+		t.Fatalf("unexpected header %+v", d.Header)
+	}
+
+	y = NewYencEncoder(ioutil.Discard, YencHeader{Name: "short.bin", Size: 10})
+	y.Write([]byte("short"))
+	if err := y.Close(); err == nil {
+		t.Fatal("expected an error writing less than the declared size")
+	}
+}
+
+func TestPostBinary(t *testing.T) {
+	var cmdbuf bytes.Buffer
+	var fake faker
+	fake.Writer = &cmdbuf
+
+	server := strings.Repeat("340 Go ahead\r\n240 Article received OK\r\n", 3)
+	conn := &Conn{conn: fake, w: fake, r: bufio.NewReader(strings.NewReader(server))}
+
+	data := make([]byte, 2500)
+	for i := range data {
+		data[i] = byte(i * 7)
+	}
+	ids, err := conn.PostBinary(Binary{
+		Name:       "café.tar",
+		From:       "Café Builder <builder@example.com>",
+		Newsgroups: []string{"alt.binaries.test"},
+		Domain:     "example.com",
+		PartSize:   1000,
+	}, bytes.NewReader(data), int64(len(data)))
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(ids) != 3 || !strings.HasSuffix(ids[0], "@example.com>") || ids[0] == ids[1] {
+		t.Fatalf("unexpected message-ids %q", ids)
+	}
+
+	posts := strings.Split(cmdbuf.String(), "POST\r\n")[1:]
+	if len(posts) != 3 {
+		t.Fatalf("expected 3 posts, got %d", len(posts))
+	}
+	var decoded []byte
+	for i, post := range posts {
+		if subject := fmt.Sprintf("Subject: café.tar (%02d/03)\r\n", i+1); !strings.Contains(post, subject) {
+			t.Fatalf("expected %q in post:\n%s", subject, post)
+		}
+		if !strings.Contains(post, "From: =?UTF-8?") || strings.Contains(post, "Content-Type") {
+			t.Fatalf("expected only the From header to be encoded in post:\n%s", post)
+		}
+		d, err := NewYencDecoder(strings.NewReader(strings.Replace(post, "\r\n..", "\r\n.", -1)))
+		if err != nil {
+			t.Fatal(err)
+		}
+		part, err := ioutil.ReadAll(d)
+		if err != nil {
+			t.Fatal(err)
+		}
+		if d.Header.Part != i+1 || d.Header.Total != 3 || d.Header.Begin != int64(i*1000+1) {
+			t.Fatalf("unexpected header %+v", d.Header)
+		}
+		decoded = append(decoded, part...)
+	}
+	if !bytes.Equal(decoded, data) {
+		t.Fatal("decoded parts don't match the posted data")
+	}
+}
@@ -0,0 +1,160 @@
+package nntp
+
+import (
+	"bufio"
+	"fmt"
+	"hash"
+	"hash/crc32"
+	"io"
+)
+
+// DefaultYencLineLength is the line length a YencEncoder uses if the
+// header doesn't give one.
+const DefaultYencLineLength = 128
+
+// A YencEncoder yEnc encodes the data written to it.
+//
+// The =ybegin line, and the =ypart line if the header has a part number,
+// are written before the data, and the =yend line, with the size and
+// CRC32 of the data, when the encoder is closed. Close returns a YencError
+// if less or more data was written than the header declares.
+type YencEncoder struct {
+	w   *bufio.Writer
+	h   YencHeader
+	err error
+
+	started bool
+	col     int  // length of the current line
+	pending bool // whether last holds a byte still to be encoded
+	last    byte
+	n       int64
+	crc     hash.Hash32
+}
+
+// NewYencEncoder returns an encoder that writes to w the file or part
+// described by h. Name and Size must be set; Part, Total, Begin and End
+// describe a part of a multipart file. A CRC32 of the whole file, if set,
+// is included in the =yend line; for a single part file it is computed.
+func NewYencEncoder(w io.Writer, h YencHeader) *YencEncoder {
+	if h.Line <= 0 {
+		h.Line = DefaultYencLineLength
+	}
+	return &YencEncoder{w: bufio.NewWriter(w), h: h, crc: crc32.NewIEEE()}
+}
+
+func (y *YencEncoder) begin() {
+	h := y.h
+	y.started = true
+	if h.Part == 0 {
+		fmt.Fprintf(y.w, "=ybegin line=%d size=%d name=%s\r\n", h.Line, h.Size, h.Name)
+		return
+	}
+	fmt.Fprintf(y.w, "=ybegin part=%d", h.Part)
+	if h.Total > 0 {
+		fmt.Fprintf(y.w, " total=%d", h.Total)
+	}
+	fmt.Fprintf(y.w, " line=%d size=%d name=%s\r\n", h.Line, h.Size, h.Name)
+	fmt.Fprintf(y.w, "=ypart begin=%d end=%d\r\n", h.Begin, h.End)
+}
+
+func (y *YencEncoder) Write(p []byte) (n int, err error) {
+	if y.err != nil {
+		return 0, y.err
+	}
+	if !y.started {
+		y.begin()
+	}
+
+	// Each byte is held back until the next arrives, as a space or tab
+	// at the very end of the data must be escaped.
+	for _, b := range p {
+		if y.pending {
+			y.encode(y.last, false)
+		}
+		y.last, y.pending = b, true
+	}
+	y.n += int64(len(p))
+	y.crc.Write(p)
+
+	// bufio.Writer errors are sticky; report the first.
+	if _, y.err = y.w.Write(nil); y.err != nil {
+		return 0, y.err
+	}
+	return len(p), nil
+}
+
+// encode writes one byte of data, which is the end of the data if final.
+func (y *YencEncoder) encode(b byte, final bool) {
+	e := b + 42
+	endOfLine := final || y.col+1 >= y.h.Line
+
+	escape := false
+	switch e {
+	case 0, '\n', '\r', '=':
+		escape = true
+	case '.':
+		escape = y.col == 0
+	case ' ', '\t':
+		escape = y.col == 0 || endOfLine
+	}
+
+	if escape {
+		y.w.WriteByte('=')
+		y.w.WriteByte(e + 64)
+		y.col += 2
+	} else {
+		y.w.WriteByte(e)
+		y.col++
+	}
+	if y.col >= y.h.Line {
+		y.w.WriteString("\r\n")
+		y.col = 0
+	}
+}
+
+// Close finishes the encoded data with the =yend line and flushes it to
+// the underlying writer, which is not closed.
+func (y *YencEncoder) Close() error {
+	if y.err != nil {
+		return y.err
+	}
+	if !y.started {
+		y.begin()
+	}
+	if y.pending {
+		y.encode(y.last, true)
+		y.pending = false
+	}
+	if y.col > 0 {
+		y.w.WriteString("\r\n")
+	}
+
+	h := y.h
+	crc := y.crc.Sum32()
+	fmt.Fprintf(y.w, "=yend size=%d", y.n)
+	if h.Part == 0 {
+		fmt.Fprintf(y.w, " crc32=%08x", crc)
+	} else {
+		fmt.Fprintf(y.w, " part=%d pcrc32=%08x", h.Part, crc)
+		if h.CRC32 != 0 {
+			fmt.Fprintf(y.w, " crc32=%08x", h.CRC32)
+		}
+	}
+	y.w.WriteString("\r\n")
+	if y.err = y.w.Flush(); y.err != nil {
+		return y.err
+	}
+
+	want := h.Size
+	if h.Part != 0 {
+		want = h.End - h.Begin + 1
+	}
+	if y.n != want {
+		y.err = YencError{Msg: fmt.Sprintf("wrote %d bytes, but the header says %d", y.n, want)}
+		return y.err
+	}
+	y.err = errEncoderClosed
+	return nil
+}
+
+var errEncoderClosed = YencError{Msg: "encoder closed"}
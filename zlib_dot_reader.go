@@ -4,7 +4,6 @@ import (
 	"bufio"
 	"compress/zlib"
 	"io"
-	"fmt"
 	"strings"
 )
 
@@ -40,7 +39,7 @@ func (z *zlibDotResponse) readDot() error {
 	if line, err := z.clear.ReadString('\n'); err != nil {
 		return err
 	} else if strings.TrimRight(line, "\r\n") != "." {
-		return ProtocolError(fmt.Sprintf(`expected "." on a line, got %+q`, line))
+		return ProtocolError{Msg: `expected "." on a line`, Line: line}
 	} else {
 		return nil
 	}